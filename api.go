@@ -109,22 +109,68 @@ func (api *API) Query(ctx context.Context, req *QueryRequest) (QueryResponse, er
 		ExcludeRowAttrs: req.ExcludeRowAttrs,
 		ExcludeColumns:  req.ExcludeColumns,
 	}
+	// Register queries received from clients so that they can be listed
+	// and cancelled.
+	if !req.Remote {
+		var done func()
+		ctx, done = api.server.queries.register(ctx, req.Index, req.Query)
+		defer done()
+	}
+
+	// Stop the query, and any requests it sent to other nodes, once it
+	// runs past its timeout.
+	if timeout := api.queryTimeout(req.Timeout); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var qp *queryProfile
+	if req.Profile {
+		qp = &queryProfile{}
+		ctx = withQueryProfile(ctx, qp)
+	}
 	results, err := api.server.executor.Execute(ctx, req.Index, q, req.Shards, execOpts)
 	if err != nil {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			err = ErrQueryTimeout
+		case context.Canceled:
+			err = ErrQueryCancelled
+		}
 		return resp, errors.Wrap(err, "executing")
 	}
 	resp.Results = results
+	if qp != nil {
+		resp.Profile = qp.calls
+	}
+
+	// Note time ranges which a rollup has made less precise. Remote calls
+	// leave this to the coordinating node.
+	if !req.Remote {
+		now := time.Now()
+		for _, call := range q.Calls {
+			resp.Warnings = append(resp.Warnings, api.server.executor.timeRollupWarnings(req.Index, call, now)...)
+		}
+	}
 
 	// Fill column attributes if requested.
 	if req.ColumnAttrs && !req.ExcludeColumns {
 		// Consolidate all column ids across all calls.
 		var columnIDs []uint64
 		for _, result := range results {
-			bm, ok := result.(*Row)
-			if !ok {
-				continue
+			switch result := result.(type) {
+			case *Row:
+				columnIDs = uint64Slice(columnIDs).merge(result.Columns())
+			case ExtractedTable:
+				ids := make([]uint64, 0, len(result.Columns))
+				for _, col := range result.Columns {
+					if col.Key == "" {
+						ids = append(ids, col.ID)
+					}
+				}
+				columnIDs = uint64Slice(columnIDs).merge(ids)
 			}
-			columnIDs = uint64Slice(columnIDs).merge(bm.Columns())
 		}
 
 		// Retrieve column attributes across all calls.
@@ -149,6 +195,19 @@ func (api *API) Query(ctx context.Context, req *QueryRequest) (QueryResponse, er
 	return resp, nil
 }
 
+// queryTimeout returns the timeout of a query which asked for timeout. Queries
+// which ask for none use the server's default, and none may exceed the
+// server's maximum. Returns zero if the query has no timeout.
+func (api *API) queryTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		timeout = api.server.defaultQueryTimeout
+	}
+	if max := api.server.maxQueryTimeout; max > 0 && (timeout == 0 || timeout > max) {
+		timeout = max
+	}
+	return timeout
+}
+
 // readColumnAttrSets returns a list of column attribute objects by id.
 func (api *API) readColumnAttrSets(index *Index, ids []uint64) ([]*ColumnAttrSet, error) {
 	if index == nil {
@@ -236,18 +295,17 @@ func (api *API) DeleteIndex(ctx context.Context, indexName string) error {
 }
 
 // CreateField makes the named field in the named index with the given options.
-// This method currently only takes a single functional option, but that may be
-// changed in the future to support multiple options.
-func (api *API) CreateField(ctx context.Context, indexName string, fieldName string, opts FieldOption) (*Field, error) {
+func (api *API) CreateField(ctx context.Context, indexName string, fieldName string, opts ...FieldOption) (*Field, error) {
 	if err := api.validate(apiCreateField); err != nil {
 		return nil, errors.Wrap(err, "validating api method")
 	}
 
-	// Apply functional option.
+	// Apply functional options.
 	fo := FieldOptions{}
-	err := opts(&fo)
-	if err != nil {
-		return nil, errors.Wrap(err, "applying option")
+	for _, opt := range opts {
+		if err := opt(&fo); err != nil {
+			return nil, errors.Wrap(err, "applying option")
+		}
 	}
 
 	// Find index.
@@ -517,6 +575,87 @@ func (api *API) ClusterMessage(ctx context.Context, reqBody io.Reader) error {
 	return nil
 }
 
+// queryNodeTimeout bounds how long listing or cancelling queries waits for
+// each remote node, so that an unreachable node does not hold up the others.
+const queryNodeTimeout = 5 * time.Second
+
+// ActiveQueries returns the queries running across the cluster, oldest first.
+// Nodes which fail to list their queries are left out, and their errors are
+// returned by node ID.
+func (api *API) ActiveQueries(ctx context.Context) ([]ActiveQuery, map[string]error) {
+	type nodeQueries struct {
+		id      string
+		queries []ActiveQuery
+		err     error
+	}
+
+	// Ask the remote nodes in parallel.
+	nodes := Nodes(api.cluster.Nodes).FilterID(api.LocalID())
+	ch := make(chan nodeQueries, len(nodes))
+	for _, node := range nodes {
+		go func(node *Node) {
+			ctx, cancel := context.WithTimeout(ctx, queryNodeTimeout)
+			defer cancel()
+			queries, err := api.server.defaultClient.ActiveQueries(ctx, &node.URI)
+			ch <- nodeQueries{id: node.ID, queries: queries, err: err}
+		}(node)
+	}
+
+	queries := api.server.queries.list()
+	var errs map[string]error
+	for range nodes {
+		nq := <-ch
+		if nq.err != nil {
+			if errs == nil {
+				errs = make(map[string]error)
+			}
+			errs[nq.id] = errors.Wrapf(nq.err, "getting queries from node %s", nq.id)
+			continue
+		}
+		queries = append(queries, nq.queries...)
+	}
+	sortActiveQueries(queries)
+	return queries, errs
+}
+
+// LocalActiveQueries returns the queries running on this node, oldest first.
+func (api *API) LocalActiveQueries(ctx context.Context) []ActiveQuery {
+	return api.server.queries.list()
+}
+
+// CancelQuery cancels a query running on any node of the cluster. Nodes which
+// cannot be reached are skipped, and only reported if no other node has the
+// query.
+func (api *API) CancelQuery(ctx context.Context, id string) error {
+	if api.server.queries.cancel(id) {
+		return nil
+	}
+
+	var errs []string
+	for _, node := range Nodes(api.cluster.Nodes).FilterID(api.LocalID()) {
+		nodeCtx, cancel := context.WithTimeout(ctx, queryNodeTimeout)
+		err := api.server.defaultClient.CancelQuery(nodeCtx, &node.URI, id)
+		cancel()
+		if err == nil {
+			return nil
+		} else if errors.Cause(err) != ErrQueryNotFound {
+			errs = append(errs, fmt.Sprintf("node %s: %s", node.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("query not found on the nodes reached, cancelling failed on %s", strings.Join(errs, "; "))
+	}
+	return NewNotFoundError(ErrQueryNotFound)
+}
+
+// CancelLocalQuery cancels a query running on this node.
+func (api *API) CancelLocalQuery(ctx context.Context, id string) error {
+	if !api.server.queries.cancel(id) {
+		return NewNotFoundError(ErrQueryNotFound)
+	}
+	return nil
+}
+
 // LocalID returns the current node's ID.
 func (api *API) LocalID() string {
 	return api.cluster.Node.ID
@@ -654,7 +793,7 @@ func (api *API) Import(ctx context.Context, req internal.ImportRequest) error {
 		return errors.Wrap(err, "validating api method")
 	}
 
-	_, field, err := api.indexField(req.Index, req.Field, req.Shard)
+	index, field, err := api.indexField(req.Index, req.Field, req.Shard)
 	if err != nil {
 		return errors.Wrap(err, "getting field")
 	}
@@ -673,26 +812,42 @@ func (api *API) Import(ctx context.Context, req internal.ImportRequest) error {
 	err = field.Import(req.RowIDs, req.ColumnIDs, timestamps)
 	if err != nil {
 		api.server.logger.Printf("import error: index=%s, field=%s, shard=%d, columns=%d, err=%s", req.Index, req.Field, req.Shard, len(req.ColumnIDs), err)
+		return errors.Wrap(err, "importing")
 	}
-	return errors.Wrap(err, "importing")
+	return errors.Wrap(api.importExistence(index, req.ColumnIDs), "importing existence")
 }
 
-// ImportValue bulk imports values into a particular field.
+// ImportValue bulk imports values into a particular field. If the request has
+// Clear set, the values of the columns are removed instead. If it has
+// Increment set, the values are added to the existing values.
 func (api *API) ImportValue(ctx context.Context, req internal.ImportValueRequest) error {
 	if err := api.validate(apiImportValue); err != nil {
 		return errors.Wrap(err, "validating api method")
 	}
 
-	_, field, err := api.indexField(req.Index, req.Field, req.Shard)
+	index, field, err := api.indexField(req.Index, req.Field, req.Shard)
 	if err != nil {
 		return errors.Wrap(err, "getting field")
 	}
 	// Import into fragment.
-	err = field.ImportValue(req.ColumnIDs, req.Values)
+	err = field.ImportValue(req.ColumnIDs, req.Values, OptImportOptionsClear(req.Clear), OptImportOptionsIncrement(req.Increment))
 	if err != nil {
 		api.server.logger.Printf("import error: index=%s, field=%s, shard=%d, columns=%d, err=%s", req.Index, req.Field, req.Shard, len(req.ColumnIDs), err)
+		return errors.Wrap(err, "importing")
+	} else if req.Clear {
+		return nil
+	}
+	return errors.Wrap(api.importExistence(index, req.ColumnIDs), "importing existence")
+}
+
+// importExistence marks the imported columns as existing if the index
+// tracks existence.
+func (api *API) importExistence(index *Index, columnIDs []uint64) error {
+	ef := index.existenceField()
+	if ef == nil {
+		return nil
 	}
-	return errors.Wrap(err, "importing")
+	return ef.Import(make([]uint64, len(columnIDs)), columnIDs, nil)
 }
 
 // MaxShards returns the maximum shard number for each index in a map.
@@ -870,6 +1025,9 @@ type apiMethod int
 
 // API validation constants.
 const (
+	//apiActiveQueries // not implemented
+	//apiCancelLocalQuery // not implemented
+	//apiCancelQuery // not implemented
 	apiClusterMessage apiMethod = iota
 	apiCreateField
 	apiCreateIndex
@@ -886,6 +1044,7 @@ const (
 	apiImportValue
 	apiIndex
 	apiIndexAttrDiff
+	//apiLocalActiveQueries // not implemented
 	//apiLocalID // not implemented
 	//apiLongQueryTime // not implemented
 	apiMarshalFragment
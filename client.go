@@ -42,7 +42,7 @@ type InternalClient interface {
 	ImportK(ctx context.Context, index, field string, bits []Bit) error
 	EnsureIndex(ctx context.Context, name string, options IndexOptions) error
 	EnsureField(ctx context.Context, indexName string, fieldName string) error
-	ImportValue(ctx context.Context, index, field string, shard uint64, vals []FieldValue) error
+	ImportValue(ctx context.Context, index, field string, shard uint64, vals []FieldValue, opts ...ImportOption) error
 	ExportCSV(ctx context.Context, index, field string, shard uint64, w io.Writer) error
 	CreateField(ctx context.Context, index, field string) error
 	FragmentBlocks(ctx context.Context, uri *URI, index, field string, shard uint64) ([]FragmentBlock, error)
@@ -51,6 +51,8 @@ type InternalClient interface {
 	RowAttrDiff(ctx context.Context, uri *URI, index, field string, blks []AttrBlock) (map[uint64]map[string]interface{}, error)
 	SendMessage(ctx context.Context, uri *URI, pb proto.Message) error
 	RetrieveShardFromURI(ctx context.Context, index, field string, shard uint64, uri URI) (io.ReadCloser, error)
+	ActiveQueries(ctx context.Context, uri *URI) ([]ActiveQuery, error)
+	CancelQuery(ctx context.Context, uri *URI, id string) error
 }
 
 //===============
@@ -109,7 +111,7 @@ func (n NopInternalClient) EnsureIndex(ctx context.Context, name string, options
 func (n NopInternalClient) EnsureField(ctx context.Context, indexName string, fieldName string) error {
 	return nil
 }
-func (n NopInternalClient) ImportValue(ctx context.Context, index, field string, shard uint64, vals []FieldValue) error {
+func (n NopInternalClient) ImportValue(ctx context.Context, index, field string, shard uint64, vals []FieldValue, opts ...ImportOption) error {
 	return nil
 }
 func (n NopInternalClient) ExportCSV(ctx context.Context, index, field string, shard uint64, w io.Writer) error {
@@ -134,3 +136,9 @@ func (n NopInternalClient) SendMessage(ctx context.Context, uri *URI, pb proto.M
 func (n NopInternalClient) RetrieveShardFromURI(ctx context.Context, index, field string, shard uint64, uri URI) (io.ReadCloser, error) {
 	return nil, nil
 }
+func (n NopInternalClient) ActiveQueries(ctx context.Context, uri *URI) ([]ActiveQuery, error) {
+	return nil, nil
+}
+func (n NopInternalClient) CancelQuery(ctx context.Context, uri *URI, id string) error {
+	return nil
+}
@@ -617,7 +617,7 @@ func (c *cluster) fragsByHost(idx *Index) fragsByHost {
 	// fieldViews is a map of field to slice of views.
 	fieldViews := make(viewsByField)
 
-	for _, field := range idx.Fields() {
+	for _, field := range idx.allFields() {
 		for _, view := range field.views() {
 			fieldViews.addView(field.Name(), view.name)
 
@@ -1216,7 +1216,7 @@ func (c *cluster) followResizeInstruction(instr *internal.ResizeInstruction) err
 				srcURI := decodeURI(src.Node.URI)
 
 				// Retrieve field.
-				f := c.holder.Field(src.Index, src.Field)
+				f := c.holder.field(src.Index, src.Field)
 				if f == nil {
 					return ErrFieldNotFound
 				}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/ctl"
 )
 
@@ -39,7 +40,9 @@ The format of the CSV file is:
 	ROWID,COLUMNID,[TIME]
 
 The file should contain no headers. The TIME column is optional and can be
-omitted. If it is present then its format should be YYYY-MM-DDTHH:MM.
+omitted. If it is present then its format should be YYYY-MM-DDTHH:MM, unless
+--timestamp-format sets it to rfc3339, or to s, ms or ns for seconds,
+milliseconds or nanoseconds since the Unix epoch.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			Importer.Paths = args
@@ -57,6 +60,7 @@ omitted. If it is present then its format should be YYYY-MM-DDTHH:MM.
 	flags.BoolVar(&Importer.StringKeys, "string-keys", false, "Treat payload as string keys.")
 	flags.IntVarP(&Importer.BufferSize, "buffer-size", "s", 10000000, "Number of bits to buffer/sort before importing.")
 	flags.BoolVarP(&Importer.Sort, "sort", "", false, "Enables sorting before import.")
+	flags.StringVarP(&Importer.TimestampFormat, "timestamp-format", "", pilosa.TimeFormat, "Format of the timestamp column: a time layout, rfc3339, s, ms or ns.")
 	flags.BoolVarP(&Importer.CreateSchema, "create", "e", false, "Create the schema if it does not exist before import.")
 	//flags.Var(&Importer.FieldOptions.TimeQuantum, "field-time-quantum", "Time quantum for the field")
 	//flags.StringVar(&Importer.FieldOptions.CacheType, "field-cache-type", pilosa.CacheTypeRanked, "Cache type for the field; valid values: none, lru, ranked")
@@ -101,6 +101,13 @@ func TestServerConfig(t *testing.T) {
 		]
 	[anti-entropy]
 		interval = "11m0s"
+	[retention]
+		interval = "30m0s"
+	[rollup]
+		interval = "45m0s"
+	[query-timeout]
+		default = "30s"
+		max = "5m0s"
 	[metric]
 		service = "statsd"
 		host = "127.0.0.1:8125"
@@ -109,6 +116,10 @@ func TestServerConfig(t *testing.T) {
 				v := validator{}
 				v.Check(cmd.Server.Config.Cluster.Hosts, []string{"localhost:19444"})
 				v.Check(cmd.Server.Config.AntiEntropy.Interval, toml.Duration(time.Minute*11))
+				v.Check(cmd.Server.Config.Retention.Interval, toml.Duration(time.Minute*30))
+				v.Check(cmd.Server.Config.Rollup.Interval, toml.Duration(time.Minute*45))
+				v.Check(cmd.Server.Config.QueryTimeout.Default, toml.Duration(time.Second*30))
+				v.Check(cmd.Server.Config.QueryTimeout.Max, toml.Duration(time.Minute*5))
 				v.Check(cmd.Server.Config.LogPath, logFile.Name())
 				v.Check(cmd.Server.Config.Metric.Service, "statsd")
 				v.Check(cmd.Server.Config.Metric.Host, "127.0.0.1:8125")
@@ -23,7 +23,6 @@ import (
 	"os"
 	"sort"
 	"strconv"
-	"time"
 
 	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/http"
@@ -58,6 +57,9 @@ type ImportCommand struct {
 	// Enables sorting of data file before import.
 	Sort bool `json:"sort"`
 
+	// Format of the optional timestamp column. See pilosa.ParseTimestamp.
+	TimestampFormat string `json:"timestampFormat"`
+
 	// Reusable client.
 	Client pilosa.InternalClient `json:"-"`
 
@@ -70,8 +72,9 @@ type ImportCommand struct {
 // NewImportCommand returns a new instance of ImportCommand.
 func NewImportCommand(stdin io.Reader, stdout, stderr io.Writer) *ImportCommand {
 	return &ImportCommand{
-		CmdIO:      pilosa.NewCmdIO(stdin, stdout, stderr),
-		BufferSize: 10000000,
+		CmdIO:           pilosa.NewCmdIO(stdin, stdout, stderr),
+		BufferSize:      10000000,
+		TimestampFormat: pilosa.TimeFormat,
 	}
 }
 
@@ -150,13 +153,14 @@ func (cmd *ImportCommand) importPath(ctx context.Context, fieldType, path string
 		if cmd.StringKeys {
 			return cmd.bufferBitsK(ctx, path)
 		} else {
-			return cmd.bufferBits(ctx, path)
+			return cmd.bufferBits(ctx, fieldType, path)
 		}
 	}
 }
 
 // bufferBits buffers slices of bits to be imported as a batch.
-func (cmd *ImportCommand) bufferBits(ctx context.Context, path string) error {
+// Rows of bool fields may be specified as true or false.
+func (cmd *ImportCommand) bufferBits(ctx context.Context, fieldType, path string) error {
 	a := make([]pilosa.Bit, 0, cmd.BufferSize)
 
 	var r *csv.Reader
@@ -197,12 +201,21 @@ func (cmd *ImportCommand) bufferBits(ctx context.Context, path string) error {
 
 		var bit pilosa.Bit
 
-		// Parse row id.
-		rowID, err := strconv.ParseUint(record[0], 10, 64)
-		if err != nil {
-			return fmt.Errorf("invalid row id on row %d: %q", rnum, record[0])
+		// Parse row id. Bool values are stored in rows 0 (false) & 1 (true).
+		if fieldType == pilosa.FieldTypeBool {
+			v, err := strconv.ParseBool(record[0])
+			if err != nil {
+				return fmt.Errorf("invalid bool value on row %d: %q", rnum, record[0])
+			} else if v {
+				bit.RowID = 1
+			}
+		} else {
+			rowID, err := strconv.ParseUint(record[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid row id on row %d: %q", rnum, record[0])
+			}
+			bit.RowID = rowID
 		}
-		bit.RowID = rowID
 
 		// Parse column id.
 		columnID, err := strconv.ParseUint(record[1], 10, 64)
@@ -213,7 +226,7 @@ func (cmd *ImportCommand) bufferBits(ctx context.Context, path string) error {
 
 		// Parse time, if exists.
 		if len(record) > 2 && record[2] != "" {
-			t, err := time.Parse(pilosa.TimeFormat, record[2])
+			t, err := pilosa.ParseTimestamp(record[2], cmd.TimestampFormat)
 			if err != nil {
 				return fmt.Errorf("invalid timestamp on row %d: %q", rnum, record[2])
 			}
@@ -318,7 +331,7 @@ func (cmd *ImportCommand) bufferBitsK(ctx context.Context, path string) error {
 
 		// Parse time, if exists.
 		if len(record) > 2 && record[2] != "" {
-			t, err := time.Parse(pilosa.TimeFormat, record[2])
+			t, err := pilosa.ParseTimestamp(record[2], cmd.TimestampFormat)
 			if err != nil {
 				return fmt.Errorf("invalid timestamp on row %d: %q", rnum, record[2])
 			}
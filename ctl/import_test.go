@@ -21,6 +21,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -101,6 +102,88 @@ func TestImportCommand_RunValue(t *testing.T) {
 	}
 }
 
+// Ensure that bool fields can be imported with true/false rows.
+func TestImportCommand_RunBool(t *testing.T) {
+	buf := bytes.Buffer{}
+	stdin, stdout, stderr := GetIO(buf)
+	cm := NewImportCommand(stdin, stdout, stderr)
+	file, err := ioutil.TempFile("", "import-bool.csv")
+	file.Write([]byte("true,1\nfalse,2\ntrue,2"))
+	ctx := context.Background()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := test.MustRunCluster(t, 1)[0]
+	cm.Host = cmd.Server.URI.HostPort()
+
+	http.DefaultClient.Do(MustNewHTTPRequest("POST", "http://"+cm.Host+"/index/i", strings.NewReader("")))
+	http.DefaultClient.Do(MustNewHTTPRequest("POST", "http://"+cm.Host+"/index/i/field/f", strings.NewReader(`{"options":{"type": "bool"}}`)))
+
+	cm.Index = "i"
+	cm.Field = "f"
+	cm.Paths = []string{file.Name()}
+	if err := cm.Run(ctx); err != nil {
+		t.Fatalf("Import Run with bools doesn't work: %s", err)
+	}
+
+	if res, err := cmd.API.Query(ctx, &pilosa.QueryRequest{Index: "i", Query: `Row(f=true) Row(f=false)`}); err != nil {
+		t.Fatal(err)
+	} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{1, 2}) {
+		t.Fatalf("unexpected true columns: %v", columns)
+	} else if columns := res.Results[1].(*pilosa.Row).Columns(); len(columns) != 0 {
+		t.Fatalf("unexpected false columns: %v", columns)
+	}
+
+	file, err = ioutil.TempFile("", "import-bool1.csv")
+	file.Write([]byte("maybe,3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm.Paths = []string{file.Name()}
+	if err := cm.Run(ctx); err == nil || !strings.Contains(err.Error(), "invalid bool value on row") {
+		t.Fatalf("expect error: invalid bool value on row, actual: %v", err)
+	}
+}
+
+// Ensure that timestamps are parsed in the requested format.
+func TestImportCommand_RunTimestampFormat(t *testing.T) {
+	buf := bytes.Buffer{}
+	stdin, stdout, stderr := GetIO(buf)
+	cm := NewImportCommand(stdin, stdout, stderr)
+	file, err := ioutil.TempFile("", "import-epoch.csv")
+	file.Write([]byte("1,2,1539696000000\n1,3,1539799200000"))
+	ctx := context.Background()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := test.MustRunCluster(t, 1)[0]
+	cm.Host = cmd.Server.URI.HostPort()
+
+	http.DefaultClient.Do(MustNewHTTPRequest("POST", "http://"+cm.Host+"/index/i", strings.NewReader("")))
+	http.DefaultClient.Do(MustNewHTTPRequest("POST", "http://"+cm.Host+"/index/i/field/f", strings.NewReader(`{"options":{"type": "time", "timeQuantum": "YMD"}}`)))
+
+	cm.Index = "i"
+	cm.Field = "f"
+	cm.TimestampFormat = pilosa.TimestampFormatEpochMilli
+	cm.Paths = []string{file.Name()}
+	if err := cm.Run(ctx); err != nil {
+		t.Fatalf("Import Run with epoch timestamps doesn't work: %s", err)
+	}
+
+	if res, err := cmd.API.Query(ctx, &pilosa.QueryRequest{Index: "i", Query: `Range(f=1, 2018-10-16T00:00, 2018-10-17T00:00)`}); err != nil {
+		t.Fatal(err)
+	} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2}) {
+		t.Fatalf("unexpected columns: %v", columns)
+	}
+
+	cm.TimestampFormat = pilosa.TimeFormat
+	if err := cm.Run(ctx); err == nil || !strings.Contains(err.Error(), "invalid timestamp on row") {
+		t.Fatalf("expect error: invalid timestamp on row, actual: %v", err)
+	}
+}
+
 func TestImportCommand_InvalidFile(t *testing.T) {
 	cmd := test.MustRunCluster(t, 1)[0]
 
@@ -27,6 +27,7 @@ func BuildServerFlags(cmd *cobra.Command, srv *server.Command) {
 	flags.StringVarP(&srv.Config.DataDir, "data-dir", "d", srv.Config.DataDir, "Directory to store pilosa data files.")
 	flags.StringVarP(&srv.Config.Bind, "bind", "b", srv.Config.Bind, "Default URI on which pilosa should listen.")
 	flags.IntVarP(&srv.Config.MaxWritesPerRequest, "max-writes-per-request", "", srv.Config.MaxWritesPerRequest, "Number of write commands per request.")
+	flags.IntVarP(&srv.Config.MaxTimeBuckets, "max-time-buckets", "", srv.Config.MaxTimeBuckets, "Number of time buckets per CountByTime call. 0 means no limit.")
 	flags.StringVar(&srv.Config.LogPath, "log-path", srv.Config.LogPath, "Log path")
 	flags.BoolVar(&srv.Config.Verbose, "verbose", srv.Config.Verbose, "Enable verbose logging")
 
@@ -62,6 +63,16 @@ func BuildServerFlags(cmd *cobra.Command, srv *server.Command) {
 	// AntiEntropy
 	flags.DurationVarP((*time.Duration)(&srv.Config.AntiEntropy.Interval), "anti-entropy.interval", "", (time.Duration)(srv.Config.AntiEntropy.Interval), "Interval at which to run anti-entropy routine.")
 
+	// Retention
+	flags.DurationVarP((*time.Duration)(&srv.Config.Retention.Interval), "retention.interval", "", (time.Duration)(srv.Config.Retention.Interval), "Interval at which to delete time views past their field's retention.")
+
+	// Rollup
+	flags.DurationVarP((*time.Duration)(&srv.Config.Rollup.Interval), "rollup.interval", "", (time.Duration)(srv.Config.Rollup.Interval), "Interval at which to delete time views past their field's rollup.")
+
+	// Query timeout
+	flags.DurationVarP((*time.Duration)(&srv.Config.QueryTimeout.Default), "query-timeout.default", "", (time.Duration)(srv.Config.QueryTimeout.Default), "Timeout of queries which do not set one. 0 means no timeout.")
+	flags.DurationVarP((*time.Duration)(&srv.Config.QueryTimeout.Max), "query-timeout.max", "", (time.Duration)(srv.Config.QueryTimeout.Max), "Longest timeout a query may set. 0 means no limit.")
+
 	// Metric
 	flags.StringVarP(&srv.Config.Metric.Service, "metric.service", "", srv.Config.Metric.Service, "Default URI on which pilosa should listen.")
 	flags.StringVarP(&srv.Config.Metric.Host, "metric.host", "", srv.Config.Metric.Host, "Default URI to send metrics.")
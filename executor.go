@@ -17,7 +17,11 @@ package pilosa
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pilosa/pilosa/internal"
@@ -51,8 +55,16 @@ type executor struct {
 	// Maximum number of Set() or Clear() commands per request.
 	MaxWritesPerRequest int
 
+	// Maximum number of buckets a CountByTime() call may count.
+	MaxTimeBuckets int
+
 	// Stores key/id translation data.
 	TranslateStore TranslateStore
+
+	// Serializes guarded Set() calls on this node against the Set() and
+	// Clear() calls which write their precondition's fragment, so that the
+	// precondition cannot change between its check and its write.
+	conditionLocks conditionLocks
 }
 
 // executorOption is a functional option type for pilosa.Executor
@@ -101,10 +113,24 @@ func (e *executor) Execute(ctx context.Context, index string, q *pql.Query, shar
 		opt = &execOptions{}
 	}
 
-	// Translate query keys to ids, if necessary.
-	for i := range q.Calls {
-		if err := e.translateCall(index, idx, q.Calls[i]); err != nil {
-			return nil, err
+	// Translate query keys to ids, if necessary. Remote calls have already
+	// been translated by the coordinating node.
+	if !opt.Remote {
+		for i := range q.Calls {
+			if err := e.translateCall(index, idx, q.Calls[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Resolve relative time ranges against a single instant on the
+	// coordinating node so that every shard queries the same views.
+	if !opt.Remote {
+		now := time.Now().UTC()
+		for i := range q.Calls {
+			if err := resolveTimeRanges(q.Calls[i], now); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -113,11 +139,14 @@ func (e *executor) Execute(ctx context.Context, index string, q *pql.Query, shar
 		return nil, err
 	}
 
-	// Translate response objects from ids to keys, if necessary.
-	for i := range results {
-		results[i], err = e.translateResult(index, idx, q.Calls[i], results[i])
-		if err != nil {
-			return nil, err
+	// Translate response objects from ids to keys, if necessary. Results
+	// sent back to a coordinating node are left as ids so they can be merged.
+	if !opt.Remote {
+		for i := range results {
+			results[i], err = e.translateResult(index, idx, q.Calls[i], results[i])
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 	return results, nil
@@ -149,10 +178,16 @@ func (e *executor) execute(ctx context.Context, index string, q *pql.Query, shar
 		return e.executeBulkSetRowAttrs(ctx, index, q.Calls, opt)
 	}
 
-	// Execute each call serially.
+	// Execute each call serially, profiling each if requested.
+	qp := queryProfileFrom(ctx)
 	results := make([]interface{}, 0, len(q.Calls))
 	for _, call := range q.Calls {
-		v, err := e.executeCall(ctx, index, call, shards, opt)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		callCtx, p := qp.start(ctx, call, e.Node.ID)
+		v, err := e.executeCall(callCtx, index, call, shards, opt)
+		p.stop()
 		if err != nil {
 			return nil, err
 		}
@@ -165,6 +200,8 @@ func (e *executor) execute(ctx context.Context, index string, q *pql.Query, shar
 func (e *executor) executeCall(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (interface{}, error) {
 	if err := e.validateCallArgs(c); err != nil {
 		return nil, errors.Wrap(err, "validating args")
+	} else if err := validateShift(c, true); err != nil {
+		return nil, err
 	}
 	indexTag := fmt.Sprintf("index:%s", index)
 	// Special handling for mutation and top-n calls.
@@ -172,21 +209,51 @@ func (e *executor) executeCall(ctx context.Context, index string, c *pql.Call, s
 	case "Sum":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeSum(ctx, index, c, shards, opt)
+	case "Average":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeAverage(ctx, index, c, shards, opt)
+	case "Percentile", "Median":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executePercentile(ctx, index, c, shards, opt)
+	case "PercentileCount":
+		// Only sent by a node executing Percentile() or Median().
+		if !opt.Remote {
+			return nil, errors.New("PercentileCount() is internal")
+		}
+		return e.executePercentileCount(ctx, index, c, shards, opt)
 	case "Min":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeMin(ctx, index, c, shards, opt)
 	case "Max":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeMax(ctx, index, c, shards, opt)
+	case "TopK", "BottomK":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeTopK(ctx, index, c, shards, opt)
+	case "Extract":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeExtract(ctx, index, c, shards, opt)
 	case "Clear":
 		return e.executeClearBit(ctx, index, c, opt)
 	case "Count":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeCount(ctx, index, c, shards, opt)
+	case "CountByTime":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeCountByTime(ctx, index, c, shards, opt)
 	case "Set":
 		return e.executeSetBit(ctx, index, c, opt)
 	case "SetValue":
+		if _, ok := c.Args["ifValue"]; ok {
+			return e.executeSetValueIf(ctx, index, c, opt)
+		}
 		return nil, e.executeSetValue(ctx, index, c, opt)
+	case "ClearValue":
+		return e.executeClearValue(ctx, index, c, opt)
+	case "Increment":
+		return e.executeIncrement(ctx, index, c, opt)
+	case "Store":
+		return e.executeStore(ctx, index, c, shards, opt)
 	case "SetRowAttrs":
 		return nil, e.executeSetRowAttrs(ctx, index, c, opt)
 	case "SetColumnAttrs":
@@ -194,6 +261,12 @@ func (e *executor) executeCall(ctx context.Context, index string, c *pql.Call, s
 	case "TopN":
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeTopN(ctx, index, c, shards, opt)
+	case "Rows":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeRows(ctx, index, c, shards, opt)
+	case "GroupBy":
+		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
+		return e.executeGroupBy(ctx, index, c, shards, opt)
 	default:
 		e.Holder.Stats.CountWithCustomTags(c.Name, 1, 1.0, []string{indexTag})
 		return e.executeBitmapCall(ctx, index, c, shards, opt)
@@ -219,6 +292,48 @@ func (e *executor) validateCallArgs(c *pql.Call) error {
 	return nil
 }
 
+// validateShift ensures Shift() calls are only nested in calls which keep
+// the bits a shift carries into a neighbouring shard's segment, so that they
+// are merged with that shard during the reduce. safe reports whether c itself
+// is such a position.
+func validateShift(c *pql.Call, safe bool) error {
+	if c.Name == "Shift" && !safe {
+		return errors.New("Shift() can only be nested in Count(), Union() or Shift()")
+	}
+
+	childSafe := false
+	switch c.Name {
+	case "Count", "Union", "Shift":
+		childSafe = safe
+	}
+	for _, child := range c.Children {
+		if err := validateShift(child, childSafe); err != nil {
+			return err
+		}
+	}
+	for _, v := range c.Args {
+		if call, ok := v.(*pql.Call); ok {
+			if err := validateShift(call, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasShift reports whether c or any of its children is a Shift() call.
+func hasShift(c *pql.Call) bool {
+	if c.Name == "Shift" {
+		return true
+	}
+	for _, child := range c.Children {
+		if hasShift(child) {
+			return true
+		}
+	}
+	return false
+}
+
 // executeSum executes a Sum() call.
 func (e *executor) executeSum(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
 	if field := c.Args["field"]; field == "" {
@@ -249,28 +364,34 @@ func (e *executor) executeSum(ctx context.Context, index string, c *pql.Call, sh
 	if other.Count == 0 {
 		return ValCount{}, nil
 	}
-	return other, nil
+
+	// Remote nodes return stored values so that the coordinating node can
+	// merge them before converting to the field's scale.
+	if opt.Remote {
+		return other, nil
+	}
+	return other.unscaled(e.bsiGroup(index, callArgString(c, "field"))), nil
 }
 
-// executeMin executes a Min() call.
-func (e *executor) executeMin(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
-	if field := c.Args["field"]; field == "" {
-		return ValCount{}, errors.New("Min(): field required")
+// executeAverage executes an Average() call.
+func (e *executor) executeAverage(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
+	if callArgString(c, "field") == "" {
+		return ValCount{}, errors.New("Average(): field required")
 	}
 
 	if len(c.Children) > 1 {
-		return ValCount{}, errors.New("Min() only accepts a single bitmap input")
+		return ValCount{}, errors.New("Average() only accepts a single bitmap input")
 	}
 
 	// Execute calls in bulk on each remote node and merge.
 	mapFn := func(shard uint64) (interface{}, error) {
-		return e.executeMinShard(ctx, index, c, shard)
+		return e.executeSumCountShard(ctx, index, c, shard)
 	}
 
 	// Merge returned results at coordinating node.
 	reduceFn := func(prev, v interface{}) interface{} {
 		other, _ := prev.(ValCount)
-		return other.Smaller(v.(ValCount))
+		return other.Add(v.(ValCount))
 	}
 
 	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
@@ -282,767 +403,2342 @@ func (e *executor) executeMin(ctx context.Context, index string, c *pql.Call, sh
 	if other.Count == 0 {
 		return ValCount{}, nil
 	}
-	return other, nil
+
+	// Remote nodes return the sum so that the coordinating node can merge it.
+	if opt.Remote {
+		return other, nil
+	}
+	avg := other.average()
+	return avg.unscaled(e.bsiGroup(index, callArgString(c, "field"))), nil
 }
 
-// executeMax executes a Max() call.
-func (e *executor) executeMax(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
-	if field := c.Args["field"]; field == "" {
-		return ValCount{}, errors.New("Max(): field required")
+// executePercentile executes a Percentile() or Median() call.
+//
+// The nth percentile is found by walking the bit slices of the bsiGroup from
+// the most significant bit down. At each bit, the columns whose value has the
+// bit unset are counted across the cluster. This determines whether the
+// target value has the bit set and narrows the range for the next bit.
+func (e *executor) executePercentile(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
+	fieldName := callArgString(c, "field")
+	if fieldName == "" {
+		return ValCount{}, fmt.Errorf("%s(): field required", c.Name)
 	}
 
 	if len(c.Children) > 1 {
-		return ValCount{}, errors.New("Max() only accepts a single bitmap input")
+		return ValCount{}, fmt.Errorf("%s() only accepts a single bitmap input", c.Name)
 	}
 
-	// Execute calls in bulk on each remote node and merge.
-	mapFn := func(shard uint64) (interface{}, error) {
-		return e.executeMaxShard(ctx, index, c, shard)
+	nth := 50.0
+	if c.Name == "Percentile" {
+		switch v := c.Args["nth"].(type) {
+		case int64:
+			nth = float64(v)
+		case float64:
+			nth = v
+		case nil:
+			return ValCount{}, errors.New("Percentile(): nth required")
+		default:
+			return ValCount{}, fmt.Errorf("Percentile(): invalid nth type: %T", v)
+		}
+		if nth < 0 || nth > 100 {
+			return ValCount{}, errors.New("Percentile(): nth must be between 0 and 100")
+		}
 	}
 
-	// Merge returned results at coordinating node.
-	reduceFn := func(prev, v interface{}) interface{} {
-		other, _ := prev.(ValCount)
-		return other.Larger(v.(ValCount))
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return ValCount{}, ErrFieldNotFound
+	}
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return ValCount{}, ErrBSIGroupNotFound
 	}
 
-	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	// count returns the number of columns with a stored value between lo and
+	// hi, restricted to the filter, if one was provided. The range is kept in
+	// stored integers so that it is not rounded through the field's scale.
+	count := func(lo, hi int64) (uint64, error) {
+		vc, err := e.executePercentileCount(ctx, index, &pql.Call{
+			Name:     "PercentileCount",
+			Args:     map[string]interface{}{"field": fieldName, "lo": lo, "hi": hi},
+			Children: c.Children,
+		}, shards, opt)
+		return uint64(vc.Count), err
+	}
+
+	n, err := count(bsig.Min, bsig.Max)
 	if err != nil {
 		return ValCount{}, err
+	} else if n == 0 {
+		return ValCount{}, nil
 	}
-	other, _ := result.(ValCount)
 
-	if other.Count == 0 {
-		return ValCount{}, nil
+	// Determine the zero-based rank of the value using the nearest-rank method.
+	k := uint64(math.Ceil(nth / 100 * float64(n)))
+	if k > 0 {
+		k--
 	}
-	return other, nil
+
+	var base uint64
+	for i := int(bsig.BitDepth()) - 1; i >= 0; i-- {
+		// Count values in the lower half of the remaining range.
+		lo := int64(base) + bsig.Min
+		hi := lo + (1 << uint(i)) - 1
+		cnt, err := count(lo, hi)
+		if err != nil {
+			return ValCount{}, err
+		}
+
+		if k >= cnt {
+			k -= cnt
+			base |= 1 << uint(i)
+		}
+	}
+
+	result := ValCount{Val: int64(base) + bsig.Min, Count: int64(n)}
+	return result.unscaled(bsig), nil
 }
 
-// executeBitmapCall executes a call that returns a bitmap.
-func (e *executor) executeBitmapCall(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (*Row, error) {
+// executePercentileCount executes a PercentileCount() call, which counts the
+// columns with a stored value between its lo and hi arguments. It is the call
+// sent to remote nodes for each step of executePercentile.
+func (e *executor) executePercentileCount(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
 	// Execute calls in bulk on each remote node and merge.
 	mapFn := func(shard uint64) (interface{}, error) {
-		return e.executeBitmapCallShard(ctx, index, c, shard)
+		return e.executePercentileCountShard(ctx, index, c, shard)
 	}
 
 	// Merge returned results at coordinating node.
 	reduceFn := func(prev, v interface{}) interface{} {
-		other, _ := prev.(*Row)
-		if other == nil {
-			other = NewRow()
-		}
-		other.Merge(v.(*Row))
-		return other
+		other, _ := prev.(ValCount)
+		return other.Add(v.(ValCount))
 	}
 
-	other, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
 	if err != nil {
-		return nil, err
-	}
-
-	// Attach attributes for Row() calls.
-	// If the column label is used then return column attributes.
-	// If the row label is used then return bitmap attributes.
-	row, _ := other.(*Row)
-	if c.Name == "Row" {
-		if opt.ExcludeRowAttrs {
-			row.Attrs = map[string]interface{}{}
-		} else {
-			idx := e.Holder.Index(index)
-			if idx != nil {
-				if columnID, ok, err := c.UintArg("_" + columnLabel); ok && err == nil {
-					attrs, err := idx.ColumnAttrStore().Attrs(columnID)
-					if err != nil {
-						return nil, errors.Wrap(err, "getting column attrs")
-					}
-					row.Attrs = attrs
-				} else if err != nil {
-					return nil, err
-				} else {
-					// field, _ := c.Args["field"].(string)
-					fieldName, _ := c.FieldArg()
-					if fr := idx.Field(fieldName); fr != nil {
-						rowID, _, err := c.UintArg(fieldName)
-						if err != nil {
-							return nil, errors.Wrap(err, "getting row")
-						}
-						attrs, err := fr.RowAttrStore().Attrs(rowID)
-						if err != nil {
-							return nil, errors.Wrap(err, "getting row attrs")
-						}
-						row.Attrs = attrs
-					}
-				}
-			}
-		}
-	}
-
-	if opt.ExcludeColumns {
-		row.segments = []RowSegment{}
-	}
-
-	return row, nil
-}
-
-// executeBitmapCallShard executes a bitmap call for a single shard.
-func (e *executor) executeBitmapCallShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	switch c.Name {
-	case "Row":
-		return e.executeBitmapShard(ctx, index, c, shard)
-	case "Difference":
-		return e.executeDifferenceShard(ctx, index, c, shard)
-	case "Intersect":
-		return e.executeIntersectShard(ctx, index, c, shard)
-	case "Range":
-		return e.executeRangeShard(ctx, index, c, shard)
-	case "Union":
-		return e.executeUnionShard(ctx, index, c, shard)
-	case "Xor":
-		return e.executeXorShard(ctx, index, c, shard)
-	default:
-		return nil, fmt.Errorf("unknown call: %s", c.Name)
+		return ValCount{}, err
 	}
+	other, _ := result.(ValCount)
+	return other, nil
 }
 
-// executeSumCountShard calculates the sum and count for bsiGroups on a shard.
-func (e *executor) executeSumCountShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
-	var filter *Row
-	if len(c.Children) == 1 {
-		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
-		if err != nil {
-			return ValCount{}, errors.Wrap(err, "executing bitmap call")
-		}
-		filter = row
-	}
-
-	fieldName, _ := c.Args["field"].(string)
+// executePercentileCountShard counts the columns with a stored value between
+// the lo and hi arguments of a PercentileCount() call on a shard.
+func (e *executor) executePercentileCountShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
+	fieldName := callArgString(c, "field")
+	lo, _ := c.Args["lo"].(int64)
+	hi, _ := c.Args["hi"].(int64)
 
 	field := e.Holder.Field(index, fieldName)
 	if field == nil {
 		return ValCount{}, nil
 	}
-
 	bsig := field.bsiGroup(fieldName)
 	if bsig == nil {
 		return ValCount{}, nil
 	}
 
-	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-	if fragment == nil {
+	baseValueMin, baseValueMax, outOfRange := bsig.baseValueBetween(lo, hi)
+	if outOfRange {
 		return ValCount{}, nil
 	}
 
-	vsum, vcount, err := fragment.sum(filter, bsig.BitDepth())
+	frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+	if frag == nil {
+		return ValCount{}, nil
+	}
+	callProfilerFrom(ctx).readRows(frag, 0, uint64(bsig.BitDepth())+1)
+
+	var row *Row
+	var err error
+	if lo <= bsig.Min && hi >= bsig.Max {
+		row, err = frag.notNull(bsig.BitDepth())
+	} else {
+		row, err = frag.rangeBetween(bsig.BitDepth(), baseValueMin, baseValueMax)
+	}
 	if err != nil {
-		return ValCount{}, errors.Wrap(err, "computing sum")
+		return ValCount{}, err
 	}
-	return ValCount{
-		Val:   int64(vsum) + (int64(vcount) * bsig.Min),
-		Count: int64(vcount),
-	}, nil
-}
 
-// executeMinShard calculates the min for bsiGroups on a shard.
-func (e *executor) executeMinShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
-	var filter *Row
 	if len(c.Children) == 1 {
-		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		filter, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
 		if err != nil {
 			return ValCount{}, err
 		}
-		filter = row
+		row = row.Intersect(filter)
 	}
+	return ValCount{Count: int64(row.Count())}, nil
+}
 
-	fieldName, _ := c.Args["field"].(string)
+// executeMin executes a Min() call.
+func (e *executor) executeMin(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
+	if field := c.Args["field"]; field == "" {
+		return ValCount{}, errors.New("Min(): field required")
+	}
 
-	field := e.Holder.Field(index, fieldName)
-	if field == nil {
-		return ValCount{}, nil
+	if len(c.Children) > 1 {
+		return ValCount{}, errors.New("Min() only accepts a single bitmap input")
 	}
 
-	bsig := field.bsiGroup(fieldName)
-	if bsig == nil {
-		return ValCount{}, nil
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeMinShard(ctx, index, c, shard)
 	}
 
-	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-	if fragment == nil {
-		return ValCount{}, nil
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(ValCount)
+		return other.Smaller(v.(ValCount))
 	}
 
-	fmin, fcount, err := fragment.min(filter, bsig.BitDepth())
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
 	if err != nil {
 		return ValCount{}, err
 	}
-	return ValCount{
-		Val:   int64(fmin) + bsig.Min,
-		Count: int64(fcount),
-	}, nil
-}
+	other, _ := result.(ValCount)
 
-// executeMaxShard calculates the max for bsiGroups on a shard.
-func (e *executor) executeMaxShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
-	var filter *Row
-	if len(c.Children) == 1 {
-		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
-		if err != nil {
-			return ValCount{}, err
-		}
-		filter = row
+	if other.Count == 0 {
+		return ValCount{}, nil
 	}
 
-	fieldName, _ := c.Args["field"].(string)
-
-	field := e.Holder.Field(index, fieldName)
-	if field == nil {
-		return ValCount{}, nil
+	if opt.Remote {
+		return other, nil
 	}
+	return other.unscaled(e.bsiGroup(index, callArgString(c, "field"))), nil
+}
 
-	bsig := field.bsiGroup(fieldName)
-	if bsig == nil {
-		return ValCount{}, nil
+// executeMax executes a Max() call.
+func (e *executor) executeMax(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ValCount, error) {
+	if field := c.Args["field"]; field == "" {
+		return ValCount{}, errors.New("Max(): field required")
 	}
 
-	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-	if fragment == nil {
-		return ValCount{}, nil
+	if len(c.Children) > 1 {
+		return ValCount{}, errors.New("Max() only accepts a single bitmap input")
 	}
 
-	fmax, fcount, err := fragment.max(filter, bsig.BitDepth())
-	if err != nil {
-		return ValCount{}, err
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeMaxShard(ctx, index, c, shard)
 	}
-	return ValCount{
-		Val:   int64(fmax) + bsig.Min,
-		Count: int64(fcount),
-	}, nil
-}
 
-// executeTopN executes a TopN() call.
-// This first performs the TopN() to determine the top results and then
-// requeries to retrieve the full counts for each of the top results.
-func (e *executor) executeTopN(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]Pair, error) {
-	idsArg, _, err := c.UintSliceArg("ids")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopN: %v", err)
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(ValCount)
+		return other.Larger(v.(ValCount))
 	}
-	n, _, err := c.UintArg("n")
+
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
 	if err != nil {
-		return nil, fmt.Errorf("executeTopN: %v", err)
+		return ValCount{}, err
 	}
+	other, _ := result.(ValCount)
 
-	// Execute original query.
-	pairs, err := e.executeTopNShards(ctx, index, c, shards, opt)
-	if err != nil {
-		return nil, errors.Wrap(err, "finding top results")
+	if other.Count == 0 {
+		return ValCount{}, nil
 	}
 
-	// If this call is against specific ids, or we didn't get results,
-	// or we are part of a larger distributed query then don't refetch.
-	if len(pairs) == 0 || len(idsArg) > 0 || opt.Remote {
-		return pairs, nil
+	if opt.Remote {
+		return other, nil
 	}
-	// Only the original caller should refetch the full counts.
-	other := c.Clone()
+	return other.unscaled(e.bsiGroup(index, callArgString(c, "field"))), nil
+}
 
-	ids := Pairs(pairs).Keys()
-	sort.Sort(uint64Slice(ids))
-	other.Args["ids"] = ids
+// executeTopK executes a TopK() or BottomK() call.
+func (e *executor) executeTopK(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]ColumnValue, error) {
+	fieldName := callArgString(c, "field")
+	if fieldName == "" {
+		return nil, fmt.Errorf("%s(): field required", c.Name)
+	}
 
-	trimmedList, err := e.executeTopNShards(ctx, index, other, shards, opt)
+	n, _, err := c.UintArg("n")
 	if err != nil {
-		return nil, errors.Wrap(err, "retrieving full counts")
+		return nil, errors.Wrap(err, "getting n")
+	} else if n == 0 {
+		return nil, fmt.Errorf("%s(): n required", c.Name)
 	}
 
-	if n != 0 && int(n) < len(trimmedList) {
-		trimmedList = trimmedList[0:n]
+	if filter, ok := c.Args["filter"]; ok {
+		if _, ok := filter.(*pql.Call); !ok {
+			return nil, fmt.Errorf("%s(): filter must be a row query", c.Name)
+		}
 	}
-	return trimmedList, nil
-}
 
-func (e *executor) executeTopNShards(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]Pair, error) {
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return nil, ErrFieldNotFound
+	} else if field.bsiGroup(fieldName) == nil {
+		return nil, ErrBSIGroupNotFound
+	}
+	bottom := c.Name == "BottomK"
+
 	// Execute calls in bulk on each remote node and merge.
 	mapFn := func(shard uint64) (interface{}, error) {
-		return e.executeTopNShard(ctx, index, c, shard)
+		return e.executeTopKShard(ctx, index, c, shard)
 	}
 
 	// Merge returned results at coordinating node.
 	reduceFn := func(prev, v interface{}) interface{} {
-		other, _ := prev.([]Pair)
-		return Pairs(other).Add(v.([]Pair))
+		other, _ := prev.([]ColumnValue)
+		return mergeColumnValues(other, v.([]ColumnValue), int(n), bottom)
 	}
 
-	other, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
 	if err != nil {
 		return nil, err
 	}
-	results, _ := other.([]Pair)
-
-	// Sort final merged results.
-	sort.Sort(Pairs(results))
-
-	return results, nil
-}
-
-// executeTopNShard executes a TopN call for a single shard.
-func (e *executor) executeTopNShard(ctx context.Context, index string, c *pql.Call, shard uint64) ([]Pair, error) {
-	field, _ := c.Args["_field"].(string)
-	n, _, err := c.UintArg("n")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNShard: %v", err)
-	}
-	attrName, _ := c.Args["attrName"].(string)
-	rowIDs, _, err := c.UintSliceArg("ids")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	results, _ := result.([]ColumnValue)
+	if results == nil {
+		results = []ColumnValue{}
 	}
-	minThreshold, _, err := c.UintArg("threshold")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNShard: %v", err)
+
+	// Remote nodes return stored values so that the coordinating node can
+	// merge them before converting to the field's scale.
+	if opt.Remote {
+		return results, nil
 	}
-	attrValues, _ := c.Args["attrValues"].([]interface{})
-	tanimotoThreshold, _, err := c.UintArg("tanimotoThreshold")
-	if err != nil {
-		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	bsig := field.bsiGroup(fieldName)
+	for i := range results {
+		results[i] = results[i].unscaled(bsig)
 	}
+	return results, nil
+}
 
-	// Retrieve bitmap used to intersect.
-	var src *Row
-	if len(c.Children) == 1 {
-		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+// executeTopKShard returns the columns with the largest or smallest values
+// in a bsiGroup on a shard.
+func (e *executor) executeTopKShard(ctx context.Context, index string, c *pql.Call, shard uint64) ([]ColumnValue, error) {
+	var filter *Row
+	if filterCall, ok := c.Args["filter"].(*pql.Call); ok {
+		row, err := e.executeBitmapCallShard(ctx, index, filterCall, shard)
 		if err != nil {
 			return nil, err
 		}
-		src = row
-	} else if len(c.Children) > 1 {
-		return nil, errors.New("TopN() can only have one input bitmap")
+		filter = row
 	}
 
-	// Set default field.
-	if field == "" {
-		field = defaultField
+	fieldName, _ := c.Args["field"].(string)
+	n, _, err := c.UintArg("n")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting n")
 	}
 
-	f := e.Holder.fragment(index, field, ViewStandard, shard)
-	if f == nil {
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
 		return nil, nil
 	}
 
-	if minThreshold <= 0 {
-		minThreshold = defaultMinThreshold
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return nil, nil
 	}
 
-	if tanimotoThreshold > 100 {
-		return nil, errors.New("Tanimoto Threshold is from 1 to 100 only")
+	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+	if fragment == nil {
+		return nil, nil
 	}
-	return f.top(topOptions{
-		N:                 int(n),
-		Src:               src,
-		RowIDs:            rowIDs,
-		FilterName:        attrName,
-		FilterValues:      attrValues,
-		MinThreshold:      minThreshold,
-		TanimotoThreshold: tanimotoThreshold,
-	})
-}
+	callProfilerFrom(ctx).readRows(fragment, 0, uint64(bsig.BitDepth())+1)
 
-// executeDifferenceShard executes a difference() call for a local shard.
-func (e *executor) executeDifferenceShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	var other *Row
-	if len(c.Children) == 0 {
-		return nil, fmt.Errorf("empty Difference query is currently not supported")
+	columnIDs, values, err := fragment.topK(filter, bsig.BitDepth(), n, c.Name == "BottomK")
+	if err != nil {
+		return nil, err
 	}
-	for i, input := range c.Children {
-		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
-		if err != nil {
-			return nil, err
-		}
 
-		if i == 0 {
-			other = row
-		} else {
-			other = other.Difference(row)
-		}
+	results := make([]ColumnValue, len(columnIDs))
+	for i := range columnIDs {
+		results[i] = ColumnValue{ID: columnIDs[i], Value: int64(values[i]) + bsig.Min}
 	}
-	other.InvalidateCount()
-	return other, nil
+	return results, nil
 }
 
-func (e *executor) executeBitmapShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	// Fetch column label from index.
-	idx := e.Holder.Index(index)
-	if idx == nil {
-		return nil, ErrIndexNotFound
+// executeExtract executes an Extract() call.
+func (e *executor) executeExtract(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (ExtractedTable, error) {
+	if len(c.Children) != 1 {
+		return ExtractedTable{}, errors.New("Extract() requires a single row input")
 	}
 
-	// Fetch field & row label based on argument.
-	fieldName, err := c.FieldArg()
+	fields, err := extractFields(c)
 	if err != nil {
-		return nil, errors.New("Row() argument required: field")
+		return ExtractedTable{}, err
 	}
-	f := e.Holder.Field(index, fieldName)
-	if f == nil {
-		return nil, ErrFieldNotFound
+	for _, name := range fields {
+		field := e.Holder.Field(index, name)
+		if field == nil {
+			return ExtractedTable{}, ErrFieldNotFound
+		} else if field.bsiGroup(name) == nil {
+			return ExtractedTable{}, ErrBSIGroupNotFound
+		}
 	}
 
-	rowID, rowOK, rowErr := c.UintArg(fieldName)
-	if rowErr != nil {
-		return nil, fmt.Errorf("Row() error with arg for row: %v", rowErr)
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeExtractShard(ctx, index, c, shard)
 	}
-	if !rowOK {
-		return nil, fmt.Errorf("Row() must specify %v", rowLabel)
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(ExtractedTable)
+		return other.merge(v.(ExtractedTable))
 	}
 
-	frag := e.Holder.fragment(index, fieldName, ViewStandard, shard)
-	if frag == nil {
-		return NewRow(), nil
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return ExtractedTable{}, err
 	}
-	return frag.row(rowID), nil
-}
+	table, _ := result.(ExtractedTable)
+	table.Fields = fields
 
-// executeIntersectShard executes a intersect() call for a local shard.
-func (e *executor) executeIntersectShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	var other *Row
-	if len(c.Children) == 0 {
-		return nil, fmt.Errorf("empty Intersect query is currently not supported")
+	// The columns are the result of Extract(), so ExcludeColumns is ignored.
+	if table.Columns == nil {
+		table.Columns = []ExtractedColumn{}
 	}
-	for i, input := range c.Children {
-		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
-		if err != nil {
-			return nil, err
-		}
 
-		if i == 0 {
-			other = row
-		} else {
-			other = other.Intersect(row)
-		}
+	// Remote nodes return stored values; the coordinating node converts them
+	// to the scale of each field.
+	if opt.Remote {
+		return table, nil
 	}
-	other.InvalidateCount()
-	return other, nil
+	bsigs := make([]*bsiGroup, len(fields))
+	for j, name := range fields {
+		bsigs[j] = e.bsiGroup(index, name)
+	}
+	return table.unscaled(bsigs), nil
 }
 
-// executeRangeShard executes a range() call for a local shard.
-func (e *executor) executeRangeShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	// Handle bsiGroup ranges differently.
-	if c.HasConditionArg() {
-		return e.executeBSIGroupRangeShard(ctx, index, c, shard)
+// executeExtractShard reads the values of each requested field for the
+// columns of the child row on a shard.
+func (e *executor) executeExtractShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ExtractedTable, error) {
+	fields, err := extractFields(c)
+	if err != nil {
+		return ExtractedTable{}, err
 	}
 
-	// Parse field.
-	fieldName, err := c.FieldArg()
+	row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
 	if err != nil {
-		return nil, errors.New("Range() argument required: field")
+		return ExtractedTable{}, err
 	}
 
-	// Retrieve column label.
-	idx := e.Holder.Index(index)
-	if idx == nil {
-		return nil, ErrIndexNotFound
+	columnIDs := row.Columns()
+	table := ExtractedTable{
+		Fields:  fields,
+		Columns: make([]ExtractedColumn, len(columnIDs)),
 	}
-
-	// Retrieve base field.
-	f := idx.Field(fieldName)
-	if f == nil {
-		return nil, ErrFieldNotFound
+	for i, columnID := range columnIDs {
+		table.Columns[i] = ExtractedColumn{ID: columnID, Values: make([]*int64, len(fields))}
 	}
 
-	// Read row & column id.
-	rowID, rowOK, err := c.UintArg(fieldName)
-	if err != nil {
-		return nil, fmt.Errorf("executeRangeShard - reading row: %v", err)
-	}
-	if !rowOK {
-		return nil, fmt.Errorf("Range() must specify %q", rowLabel)
+	for j, name := range fields {
+		field := e.Holder.Field(index, name)
+		if field == nil {
+			continue
+		}
+
+		bsig := field.bsiGroup(name)
+		if bsig == nil {
+			continue
+		}
+
+		fragment := e.Holder.fragment(index, name, viewBSIGroupPrefix+name, shard)
+		if fragment == nil {
+			continue
+		}
+		callProfilerFrom(ctx).readRows(fragment, 0, uint64(bsig.BitDepth())+1)
+
+		values, exists := fragment.values(columnIDs, bsig.BitDepth())
+		for i := range columnIDs {
+			if exists[i] {
+				v := int64(values[i]) + bsig.Min
+				table.Columns[i].Values[j] = &v
+			}
+		}
+	}
+	return table, nil
+}
+
+// extractFields returns the list of field names passed to an Extract() call.
+func extractFields(c *pql.Call) ([]string, error) {
+	list, ok := c.Args["fields"].([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, errors.New("Extract(): fields required")
+	}
+
+	fields := make([]string, len(list))
+	for i := range list {
+		name, ok := list[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("Extract(): invalid field name: %v", list[i])
+		}
+		fields[i] = name
+	}
+	return fields, nil
+}
+
+// bsiGroup returns the bsiGroup for an int field. Returns nil if the field
+// does not exist or is not an int field.
+func (e *executor) bsiGroup(index, fieldName string) *bsiGroup {
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return nil
+	}
+	return field.bsiGroup(fieldName)
+}
+
+// executeBitmapCall executes a call that returns a bitmap.
+func (e *executor) executeBitmapCall(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (*Row, error) {
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeBitmapCallShard(ctx, index, c, shard)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(*Row)
+		if other == nil {
+			other = NewRow()
+		}
+		other.Merge(v.(*Row))
+		return other
+	}
+
+	other, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach attributes for Row() calls.
+	// If the column label is used then return column attributes.
+	// If the row label is used then return bitmap attributes.
+	row, _ := other.(*Row)
+	if c.Name == "Row" {
+		if opt.ExcludeRowAttrs {
+			row.Attrs = map[string]interface{}{}
+		} else {
+			idx := e.Holder.Index(index)
+			if idx != nil {
+				if columnID, ok, err := c.UintArg("_" + columnLabel); ok && err == nil {
+					attrs, err := idx.ColumnAttrStore().Attrs(columnID)
+					if err != nil {
+						return nil, errors.Wrap(err, "getting column attrs")
+					}
+					row.Attrs = attrs
+				} else if err != nil {
+					return nil, err
+				} else {
+					// field, _ := c.Args["field"].(string)
+					fieldName, _ := c.FieldArg()
+					if fr := idx.Field(fieldName); fr != nil {
+						rowID, _, err := c.UintArg(fieldName)
+						if err != nil {
+							return nil, errors.Wrap(err, "getting row")
+						}
+						attrs, err := fr.RowAttrStore().Attrs(rowID)
+						if err != nil {
+							return nil, errors.Wrap(err, "getting row attrs")
+						}
+						row.Attrs = attrs
+					}
+				}
+			}
+		}
+	}
+
+	if opt.ExcludeColumns {
+		row.segments = []RowSegment{}
+	}
+
+	return row, nil
+}
+
+// executeBitmapCallShard executes a bitmap call for a single shard.
+func (e *executor) executeBitmapCallShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	// Profile calls nested in the call being executed separately.
+	ctx, p := callProfilerFrom(ctx).nested(ctx, c)
+	if p != nil {
+		start := time.Now()
+		defer func() { p.addShardTime(shard, time.Since(start)) }()
+	}
+
+	switch c.Name {
+	case "Row":
+		return e.executeBitmapShard(ctx, index, c, shard)
+	case "Difference":
+		return e.executeDifferenceShard(ctx, index, c, shard)
+	case "Intersect":
+		return e.executeIntersectShard(ctx, index, c, shard)
+	case "Range":
+		return e.executeRangeShard(ctx, index, c, shard)
+	case "Union":
+		return e.executeUnionShard(ctx, index, c, shard)
+	case "Xor":
+		return e.executeXorShard(ctx, index, c, shard)
+	case "Not":
+		return e.executeNotShard(ctx, index, c, shard)
+	case "Shift":
+		return e.executeShiftShard(ctx, index, c, shard)
+	default:
+		return nil, fmt.Errorf("unknown call: %s", c.Name)
+	}
+}
+
+// executeSumCountShard calculates the sum and count for bsiGroups on a shard.
+func (e *executor) executeSumCountShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
+	var filter *Row
+	if len(c.Children) == 1 {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		if err != nil {
+			return ValCount{}, errors.Wrap(err, "executing bitmap call")
+		}
+		filter = row
+	}
+
+	fieldName, _ := c.Args["field"].(string)
+
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return ValCount{}, nil
+	}
+
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return ValCount{}, nil
+	}
+
+	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+	if fragment == nil {
+		return ValCount{}, nil
+	}
+	callProfilerFrom(ctx).readRows(fragment, 0, uint64(bsig.BitDepth())+1)
+
+	vsum, vcount, err := fragment.sum(filter, bsig.BitDepth())
+	if err != nil {
+		return ValCount{}, errors.Wrap(err, "computing sum")
+	}
+	return ValCount{
+		Val:   int64(vsum) + (int64(vcount) * bsig.Min),
+		Count: int64(vcount),
+	}, nil
+}
+
+// executeMinShard calculates the min for bsiGroups on a shard.
+func (e *executor) executeMinShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
+	var filter *Row
+	if len(c.Children) == 1 {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		if err != nil {
+			return ValCount{}, err
+		}
+		filter = row
+	}
+
+	fieldName, _ := c.Args["field"].(string)
+
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return ValCount{}, nil
+	}
+
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return ValCount{}, nil
+	}
+
+	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+	if fragment == nil {
+		return ValCount{}, nil
+	}
+	callProfilerFrom(ctx).readRows(fragment, 0, uint64(bsig.BitDepth())+1)
+
+	fmin, fcount, err := fragment.min(filter, bsig.BitDepth())
+	if err != nil {
+		return ValCount{}, err
+	}
+	return ValCount{
+		Val:   int64(fmin) + bsig.Min,
+		Count: int64(fcount),
+	}, nil
+}
+
+// executeMaxShard calculates the max for bsiGroups on a shard.
+func (e *executor) executeMaxShard(ctx context.Context, index string, c *pql.Call, shard uint64) (ValCount, error) {
+	var filter *Row
+	if len(c.Children) == 1 {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		if err != nil {
+			return ValCount{}, err
+		}
+		filter = row
+	}
+
+	fieldName, _ := c.Args["field"].(string)
+
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return ValCount{}, nil
+	}
+
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return ValCount{}, nil
+	}
+
+	fragment := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+	if fragment == nil {
+		return ValCount{}, nil
+	}
+	callProfilerFrom(ctx).readRows(fragment, 0, uint64(bsig.BitDepth())+1)
+
+	fmax, fcount, err := fragment.max(filter, bsig.BitDepth())
+	if err != nil {
+		return ValCount{}, err
+	}
+	return ValCount{
+		Val:   int64(fmax) + bsig.Min,
+		Count: int64(fcount),
+	}, nil
+}
+
+// executeTopN executes a TopN() call.
+// This first performs the TopN() to determine the top results and then
+// requeries to retrieve the full counts for each of the top results.
+func (e *executor) executeTopN(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]Pair, error) {
+	idsArg, _, err := c.UintSliceArg("ids")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopN: %v", err)
+	}
+	n, _, err := c.UintArg("n")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopN: %v", err)
+	}
+	if f := e.Holder.Field(index, callArgString(c, "_field")); f != nil && f.noStandardView() {
+		return nil, errors.New("TopN() is not supported on fields without a standard view")
+	}
+
+	// Execute original query.
+	pairs, err := e.executeTopNShards(ctx, index, c, shards, opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding top results")
+	}
+
+	// If this call is against specific ids, or we didn't get results,
+	// or we are part of a larger distributed query then don't refetch.
+	if len(pairs) == 0 || len(idsArg) > 0 || opt.Remote {
+		return pairs, nil
+	}
+	// Only the original caller should refetch the full counts.
+	other := c.Clone()
+
+	ids := Pairs(pairs).Keys()
+	sort.Sort(uint64Slice(ids))
+	other.Args["ids"] = ids
+
+	trimmedList, err := e.executeTopNShards(ctx, index, other, shards, opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieving full counts")
+	}
+
+	if n != 0 && int(n) < len(trimmedList) {
+		trimmedList = trimmedList[0:n]
+	}
+	return trimmedList, nil
+}
+
+func (e *executor) executeTopNShards(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]Pair, error) {
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeTopNShard(ctx, index, c, shard)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.([]Pair)
+		return Pairs(other).Add(v.([]Pair))
+	}
+
+	other, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return nil, err
+	}
+	results, _ := other.([]Pair)
+
+	// Sort final merged results.
+	sort.Sort(Pairs(results))
+
+	return results, nil
+}
+
+// executeTopNShard executes a TopN call for a single shard.
+func (e *executor) executeTopNShard(ctx context.Context, index string, c *pql.Call, shard uint64) ([]Pair, error) {
+	field, _ := c.Args["_field"].(string)
+	n, _, err := c.UintArg("n")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	}
+	attrName, _ := c.Args["attrName"].(string)
+	rowIDs, _, err := c.UintSliceArg("ids")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	}
+	minThreshold, _, err := c.UintArg("threshold")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	}
+	attrValues, _ := c.Args["attrValues"].([]interface{})
+	tanimotoThreshold, _, err := c.UintArg("tanimotoThreshold")
+	if err != nil {
+		return nil, fmt.Errorf("executeTopNShard: %v", err)
+	}
+
+	// Retrieve bitmap used to intersect.
+	var src *Row
+	if len(c.Children) == 1 {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		if err != nil {
+			return nil, err
+		}
+		src = row
+	} else if len(c.Children) > 1 {
+		return nil, errors.New("TopN() can only have one input bitmap")
+	}
+
+	// Set default field.
+	if field == "" {
+		field = defaultField
+	}
+
+	f := e.Holder.fragment(index, field, ViewStandard, shard)
+	if f == nil {
+		return nil, nil
+	}
+
+	if minThreshold <= 0 {
+		minThreshold = defaultMinThreshold
+	}
+
+	if tanimotoThreshold > 100 {
+		return nil, errors.New("Tanimoto Threshold is from 1 to 100 only")
+	}
+	// The rank cache serves the top rows unless specific rows are requested.
+	if p := callProfilerFrom(ctx); len(rowIDs) == 0 && f.CacheType != CacheTypeNone {
+		p.readRankCache(f)
+	} else {
+		p.touchFragment(f)
+	}
+
+	return f.top(ctx, topOptions{
+		N:                 int(n),
+		Src:               src,
+		RowIDs:            rowIDs,
+		FilterName:        attrName,
+		FilterValues:      attrValues,
+		MinThreshold:      minThreshold,
+		TanimotoThreshold: tanimotoThreshold,
+	})
+}
+
+// executeRows executes a Rows() call.
+func (e *executor) executeRows(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (RowIdentifiers, error) {
+	fieldName, err := e.rowsField(index, c)
+	if err != nil {
+		return RowIdentifiers{}, err
+	}
+
+	limit, _, err := c.UintArg("limit")
+	if err != nil {
+		return RowIdentifiers{}, errors.Wrap(err, "getting limit")
+	}
+
+	// Only the shard owning the column needs to be checked.
+	if columnID, ok, err := c.UintArg("column"); err != nil {
+		return RowIdentifiers{}, errors.Wrap(err, "getting column")
+	} else if ok {
+		shards = []uint64{columnID / ShardWidth}
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeRowsShard(ctx, index, fieldName, c, shard)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(RowIdentifiers)
+		return other.merge(v.(RowIdentifiers), int(limit))
+	}
+
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return RowIdentifiers{}, err
+	}
+	other, _ := result.(RowIdentifiers)
+	if other.Rows == nil {
+		other.Rows = []uint64{}
+	}
+	return other, nil
+}
+
+// executeRowsShard lists the rows of a field for a local shard.
+func (e *executor) executeRowsShard(ctx context.Context, index string, fieldName string, c *pql.Call, shard uint64) (RowIdentifiers, error) {
+	frag := e.Holder.fragment(index, fieldName, ViewStandard, shard)
+	if frag == nil {
+		return RowIdentifiers{}, nil
+	}
+	callProfilerFrom(ctx).touchFragment(frag)
+
+	var opt rowsOptions
+	if previous, ok, err := c.UintArg("previous"); err != nil {
+		return RowIdentifiers{}, errors.Wrap(err, "getting previous")
+	} else if ok {
+		if previous == math.MaxUint64 {
+			return RowIdentifiers{}, nil
+		}
+		opt.Start = previous + 1
+	}
+
+	limit, _, err := c.UintArg("limit")
+	if err != nil {
+		return RowIdentifiers{}, errors.Wrap(err, "getting limit")
+	}
+	opt.Limit = int(limit)
+
+	if columnID, ok, err := c.UintArg("column"); err != nil {
+		return RowIdentifiers{}, errors.Wrap(err, "getting column")
+	} else if ok {
+		opt.Column = &columnID
+	}
+
+	return RowIdentifiers{Rows: frag.rows(opt)}, nil
+}
+
+// rowsField returns the name of the field listed by a Rows() call. The field
+// may be passed positionally or with the field argument.
+func (e *executor) rowsField(index string, c *pql.Call) (string, error) {
+	fieldName := callArgString(c, "_field")
+	if fieldName == "" {
+		fieldName = callArgString(c, "field")
+	}
+	if fieldName == "" {
+		return "", errors.New("Rows(): field required")
+	}
+
+	f := e.Holder.Field(index, fieldName)
+	if f == nil {
+		return "", ErrFieldNotFound
+	} else if f.Type() == FieldTypeInt {
+		return "", fmt.Errorf("Rows() is not supported on %s fields", FieldTypeInt)
+	} else if f.noStandardView() {
+		return "", errors.New("Rows() is not supported on fields without a standard view")
+	}
+	return fieldName, nil
+}
+
+// executeGroupBy executes a GroupBy() call.
+func (e *executor) executeGroupBy(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]GroupCount, error) {
+	if len(c.Children) == 0 {
+		return nil, errors.New("GroupBy(): at least one Rows() call required")
+	}
+
+	// Validate the Rows() calls which make up each group.
+	var nPrevious int
+	for _, child := range c.Children {
+		if child.Name != "Rows" {
+			return nil, fmt.Errorf("GroupBy(): %s() is not supported, only Rows() calls are allowed", child.Name)
+		} else if _, err := e.rowsField(index, child); err != nil {
+			return nil, err
+		} else if _, ok := child.Args["limit"]; ok {
+			return nil, errors.New("GroupBy(): limit is not supported on Rows() calls")
+		}
+		if _, ok := child.Args["previous"]; ok {
+			nPrevious++
+		}
+	}
+	if nPrevious != 0 && nPrevious != len(c.Children) {
+		return nil, errors.New("GroupBy(): previous must be set on all Rows() calls or none")
+	}
+
+	if filter, ok := c.Args["filter"]; ok {
+		if _, ok := filter.(*pql.Call); !ok {
+			return nil, errors.New("GroupBy(): filter must be a row query")
+		}
+	}
+
+	limit, _, err := c.UintArg("limit")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting limit")
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		return e.executeGroupByShard(ctx, index, c, shard)
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.([]GroupCount)
+		return mergeGroupCounts(other, v.([]GroupCount), int(limit))
+	}
+
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return nil, err
+	}
+	results, _ := result.([]GroupCount)
+	if results == nil {
+		results = []GroupCount{}
+	}
+	return results, nil
+}
+
+// executeGroupByShard counts the columns in each group for a local shard.
+// Groups are returned in order and empty groups are omitted.
+func (e *executor) executeGroupByShard(ctx context.Context, index string, c *pql.Call, shard uint64) ([]GroupCount, error) {
+	var filter *Row
+	if filterCall, ok := c.Args["filter"].(*pql.Call); ok {
+		row, err := e.executeBitmapCallShard(ctx, index, filterCall, shard)
+		if err != nil {
+			return nil, err
+		}
+		filter = row
+	}
+
+	limit, _, err := c.UintArg("limit")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting limit")
+	}
+
+	// Read the fragment and the list of rows for each field in the group.
+	fields := make([]string, len(c.Children))
+	frags := make([]*fragment, len(c.Children))
+	rowIDs := make([][]uint64, len(c.Children))
+	var previous []uint64
+	for i, child := range c.Children {
+		fields[i], err = e.rowsField(index, child)
+		if err != nil {
+			return nil, err
+		}
+		if frags[i] = e.Holder.fragment(index, fields[i], ViewStandard, shard); frags[i] == nil {
+			return nil, nil
+		}
+		callProfilerFrom(ctx).touchFragment(frags[i])
+
+		var opt rowsOptions
+		if columnID, ok, err := child.UintArg("column"); err != nil {
+			return nil, errors.Wrap(err, "getting column")
+		} else if ok {
+			opt.Column = &columnID
+		}
+		rowIDs[i] = frags[i].rows(opt)
+
+		if prev, ok, err := child.UintArg("previous"); err != nil {
+			return nil, errors.Wrap(err, "getting previous")
+		} else if ok {
+			previous = append(previous, prev)
+		}
+	}
+
+	var results []GroupCount
+	group := make([]FieldRow, len(c.Children))
+
+	// Walk each combination of rows in order. The intersection of the rows
+	// chosen so far is carried down so that empty branches can be skipped.
+	// Groups up to and including the previous group are skipped if paging.
+	var walk func(depth int, row *Row, after bool)
+	walk = func(depth int, row *Row, after bool) {
+		for _, rowID := range rowIDs[depth] {
+			if limit > 0 && uint64(len(results)) >= limit {
+				return
+			} else if ctx.Err() != nil {
+				return
+			}
+
+			isPrevious := false
+			if !after && previous != nil {
+				if rowID < previous[depth] {
+					continue
+				} else if rowID == previous[depth] {
+					if depth == len(rowIDs)-1 {
+						continue
+					}
+					isPrevious = true
+				}
+			}
+
+			other := fragmentRow(ctx, frags[depth], rowID)
+			if row != nil {
+				other = row.Intersect(other)
+			}
+			if other.Count() == 0 {
+				continue
+			}
+
+			group[depth] = FieldRow{Field: fields[depth], RowID: rowID}
+			if depth < len(rowIDs)-1 {
+				walk(depth+1, other, !isPrevious)
+				continue
+			}
+
+			results = append(results, GroupCount{
+				Group: append([]FieldRow(nil), group...),
+				Count: other.Count(),
+			})
+		}
+	}
+	walk(0, filter, false)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// executeDifferenceShard executes a difference() call for a local shard.
+func (e *executor) executeDifferenceShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	var other *Row
+	if len(c.Children) == 0 {
+		return nil, fmt.Errorf("empty Difference query is currently not supported")
+	}
+	for i, input := range c.Children {
+		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			other = row
+		} else {
+			other = other.Difference(row)
+		}
+	}
+	other.InvalidateCount()
+	return other, nil
+}
+
+func (e *executor) executeBitmapShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	// Fetch column label from index.
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
+	}
+
+	// Fetch field & row label based on argument.
+	fieldName, err := c.FieldArg()
+	if err != nil {
+		return nil, errors.New("Row() argument required: field")
+	}
+	f := e.Holder.Field(index, fieldName)
+	if f == nil {
+		return nil, ErrFieldNotFound
+	}
+
+	rowID, rowOK, rowErr := c.UintArg(fieldName)
+	if rowErr != nil {
+		return nil, fmt.Errorf("Row() error with arg for row: %v", rowErr)
+	}
+	if !rowOK {
+		return nil, fmt.Errorf("Row() must specify %v", rowLabel)
+	}
+
+	// A field without a standard view holds the row in its time views only.
+	if f.noStandardView() {
+		min, max, ok := f.timeRange()
+		if !ok {
+			return NewRow(), nil
+		}
+		return e.timeRangeRow(ctx, index, f, rowID, shard, min, max)
+	}
+
+	frag := e.Holder.fragment(index, fieldName, ViewStandard, shard)
+	if frag == nil {
+		return NewRow(), nil
+	}
+	return fragmentRow(ctx, frag, rowID), nil
+}
+
+// executeIntersectShard executes a intersect() call for a local shard.
+func (e *executor) executeIntersectShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	var other *Row
+	if len(c.Children) == 0 {
+		return nil, fmt.Errorf("empty Intersect query is currently not supported")
+	}
+	for i, input := range c.Children {
+		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			other = row
+		} else {
+			other = other.Intersect(row)
+		}
+	}
+	other.InvalidateCount()
+	return other, nil
+}
+
+// executeRangeShard executes a range() call for a local shard.
+func (e *executor) executeRangeShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	// Handle bsiGroup ranges differently.
+	if c.HasConditionArg() {
+		return e.executeBSIGroupRangeShard(ctx, index, c, shard)
+	}
+
+	// Parse field.
+	fieldName, err := c.FieldArg()
+	if err != nil {
+		return nil, errors.New("Range() argument required: field")
+	}
+
+	// Retrieve column label.
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
+	}
+
+	// Retrieve base field.
+	f := idx.Field(fieldName)
+	if f == nil {
+		return nil, ErrFieldNotFound
+	}
+
+	// Read row & column id.
+	rowID, rowOK, err := c.UintArg(fieldName)
+	if err != nil {
+		return nil, fmt.Errorf("executeRangeShard - reading row: %v", err)
+	}
+	if !rowOK {
+		return nil, fmt.Errorf("Range() must specify %q", rowLabel)
+	}
+
+	// Parse start time.
+	var startTime, endTime time.Time
+	startTimeStr, hasStart := c.Args["_start"].(string)
+	if hasStart {
+		if startTime, err = time.Parse(TimeFormat, startTimeStr); err != nil {
+			return nil, errors.New("cannot parse Range() start time")
+		}
+	}
+
+	// Parse end time.
+	endTimeStr, hasEnd := c.Args["_end"].(string)
+	if hasEnd {
+		if endTime, err = time.Parse(TimeFormat, endTimeStr); err != nil {
+			return nil, errors.New("cannot parse Range() end time")
+		}
+	}
+	if !hasStart && !hasEnd {
+		return nil, errors.New("Range() start or end time required")
+	}
+
+	// Views are named in the field's time zone.
+	loc := f.timeLocation()
+	startTime, endTime = wallTime(startTime, loc), wallTime(endTime, loc)
+
+	// If no quantum exists then return an empty bitmap.
+	if f.TimeQuantum() == "" {
+		return &Row{}, nil
+	}
+
+	// Limit an open-ended range to the views held by this node. Views which
+	// do not exist locally hold no bits for the local shards.
+	if !hasStart || !hasEnd {
+		min, max, ok := f.timeRange()
+		if !ok {
+			return &Row{}, nil
+		}
+		if !hasStart {
+			startTime = min
+		}
+		if !hasEnd {
+			endTime = max
+		}
+	}
+
+	row, err := e.timeRangeRow(ctx, index, f, rowID, shard, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	f.Stats.Count("range", 1, 1.0)
+	return row, nil
+}
+
+// timeRangeRow returns the union of a row across the time views of a field
+// which cover the range from start to end for a local shard. Ranges over many
+// views stop early once ctx is cancelled.
+func (e *executor) timeRangeRow(ctx context.Context, index string, f *Field, rowID, shard uint64, start, end time.Time) (*Row, error) {
+	row := &Row{}
+	for _, view := range f.viewsByTimeRange(start, end, time.Now()) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		frag := e.Holder.fragment(index, f.Name(), view, shard)
+		if frag == nil {
+			continue
+		}
+		row = row.Union(fragmentRow(ctx, frag, rowID))
+	}
+	return row, nil
+}
+
+// resolveTimeRanges replaces the from and to arguments of time Range() and
+// CountByTime() calls in c with the absolute _start and _end times they refer
+// to at now.
+func resolveTimeRanges(c *pql.Call, now time.Time) error {
+	if (c.Name == "Range" && !c.HasConditionArg()) || c.Name == "CountByTime" {
+		for _, bound := range []struct{ arg, key string }{{"from", "_start"}, {"to", "_end"}} {
+			v, ok := c.Args[bound.arg]
+			if !ok {
+				continue
+			} else if _, ok := c.Args[bound.key]; ok {
+				return fmt.Errorf("%s() cannot combine %s with a positional time", c.Name, bound.arg)
+			}
+
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%s() %s must be a time", c.Name, bound.arg)
+			}
+			t, err := parseTimeBound(s, now)
+			if err != nil {
+				return errors.Wrapf(err, "%s() %s", c.Name, bound.arg)
+			}
+			delete(c.Args, bound.arg)
+			c.Args[bound.key] = t.Format(TimeFormat)
+		}
+	}
+
+	for _, child := range c.Children {
+		if err := resolveTimeRanges(child, now); err != nil {
+			return err
+		}
+	}
+	for _, v := range c.Args {
+		if call, ok := v.(*pql.Call); ok {
+			if err := resolveTimeRanges(call, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// timeRollupWarnings describes the time Range() and CountByTime() calls in c
+// which read views folded by a field's time rollup at now, and so are less
+// precise than the query asked for.
+func (e *executor) timeRollupWarnings(index string, c *pql.Call, now time.Time) []string {
+	var warnings []string
+	switch {
+	case c.Name == "Range" && !c.HasConditionArg():
+		if w := e.rangeRollupWarning(index, c, now); w != "" {
+			warnings = append(warnings, w)
+		}
+	case c.Name == "CountByTime":
+		if w := e.countByTimeRollupWarning(index, c, now); w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+
+	for _, child := range c.Children {
+		warnings = append(warnings, e.timeRollupWarnings(index, child, now)...)
+	}
+	for _, v := range c.Args {
+		if call, ok := v.(*pql.Call); ok {
+			warnings = append(warnings, e.timeRollupWarnings(index, call, now)...)
+		}
+	}
+	return warnings
+}
+
+// rangeRollupWarning returns a warning if the views read by a time Range()
+// call cover more time than the views it would read without a rollup.
+func (e *executor) rangeRollupWarning(index string, c *pql.Call, now time.Time) string {
+	fieldName, err := c.FieldArg()
+	if err != nil {
+		return ""
+	}
+	f := e.Holder.Field(index, fieldName)
+	if f == nil || f.Options().TimeRollup == "" {
+		return ""
+	}
+
+	// Open-ended ranges are limited to the field's views, as in
+	// executeRangeShard.
+	start, end, ok := f.timeRange()
+	if !ok {
+		return ""
+	}
+	loc := f.timeLocation()
+	if v, ok := c.Args["_start"].(string); ok {
+		t, err := time.Parse(TimeFormat, v)
+		if err != nil {
+			return ""
+		}
+		start = wallTime(t, loc)
+	}
+	if v, ok := c.Args["_end"].(string); ok {
+		t, err := time.Parse(TimeFormat, v)
+		if err != nil {
+			return ""
+		}
+		end = wallTime(t, loc)
+	}
+
+	exactStart, exactEnd, _ := timeSpanOfViews(ViewStandard, viewsByTimeRange(ViewStandard, start, end, f.TimeQuantum()))
+	foldedStart, foldedEnd, ok := timeSpanOfViews(ViewStandard, f.viewsByTimeRange(start, end, now))
+	if !ok || (!foldedStart.Before(exactStart) && !foldedEnd.After(exactEnd)) {
+		return ""
+	}
+	return fmt.Sprintf("Range(%s) reads views folded by the field's time rollup and covers %s to %s",
+		fieldName, fromWallTime(foldedStart, loc).Format(TimeFormat), fromWallTime(foldedEnd, loc).Format(TimeFormat))
+}
+
+// countByTimeRollupWarning returns a warning if a CountByTime() call has
+// buckets whose views have been folded by a field's time rollup, which are
+// counted as zero.
+func (e *executor) countByTimeRollupWarning(index string, c *pql.Call, now time.Time) string {
+	if len(c.Children) != 1 {
+		return ""
+	}
+	fieldName, err := c.Children[0].FieldArg()
+	if err != nil {
+		return ""
+	}
+	f := e.Holder.Field(index, fieldName)
+	if f == nil || f.Options().TimeRollup == "" {
+		return ""
+	}
+
+	loc := f.timeLocation()
+	unit := callArgString(c, "unit")
+	cutoffs, err := f.Options().TimeRollup.cutoffs(wallTime(now, loc))
+	if err != nil || len(unit) != 1 {
+		return ""
+	}
+	cutoff, ok := cutoffs[rune(unit[0])]
+	if !ok {
+		return ""
+	}
+
+	v, _ := c.Args["_start"].(string)
+	start, err := time.Parse(TimeFormat, v)
+	if err != nil {
+		return ""
+	}
+	_, end, ok := timeOfView(ViewStandard, viewByTimeUnit(ViewStandard, wallTime(start, loc), rune(unit[0])))
+	if !ok || end.After(cutoff) {
+		return ""
+	}
+	return fmt.Sprintf("CountByTime(%s) counts %s buckets before %s as zero, their views were folded by the field's time rollup",
+		fieldName, unit, fromWallTime(cutoff, loc).Format(TimeFormat))
+}
+
+// executeBSIGroupRangeShard executes a range(bsiGroup) call for a local shard.
+func (e *executor) executeBSIGroupRangeShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	// Only one conditional should be present.
+	if len(c.Args) == 0 {
+		return nil, errors.New("Range(): condition required")
+	} else if len(c.Args) > 1 {
+		return nil, errors.New("Range(): too many arguments")
+	}
+
+	// Extract conditional.
+	var fieldName string
+	var cond *pql.Condition
+	for k, v := range c.Args {
+		vv, ok := v.(*pql.Condition)
+		if !ok {
+			return nil, fmt.Errorf("Range(): %q: expected condition argument, got %v", k, v)
+		}
+		fieldName, cond = k, vv
+	}
+
+	f := e.Holder.Field(index, fieldName)
+	if f == nil {
+		return nil, ErrFieldNotFound
+	}
+
+	// EQ null           (not implemented: flip frag.NotNull with max ColumnID)
+	// NEQ null          frag.NotNull()
+	// BETWEEN a,b(in)   BETWEEN/frag.RangeBetween()
+	// BETWEEN a,b(out)  BETWEEN/frag.NotNull()
+	// EQ <int>          frag.RangeOp
+	// NEQ <int>         frag.RangeOp
+
+	// Handle `!= null`.
+	if cond.Op == pql.NEQ && cond.Value == nil {
+		// Find bsiGroup.
+		bsig := f.bsiGroup(fieldName)
+		if bsig == nil {
+			return nil, ErrBSIGroupNotFound
+		}
+
+		// Retrieve fragment.
+		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+		if frag == nil {
+			return NewRow(), nil
+		}
+		callProfilerFrom(ctx).readRows(frag, uint64(bsig.BitDepth()), uint64(bsig.BitDepth())+1)
+
+		return frag.notNull(bsig.BitDepth())
+
+	} else if cond.Op == pql.BETWEEN {
+
+		values, ok := cond.Value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Range(): unexpected BETWEEN condition type %T", cond.Value)
+		}
+
+		// Only support two values for the between operation.
+		if len(values) != 2 {
+			return nil, errors.New("Range(): BETWEEN condition requires exactly two integer values")
+		}
+
+		// The reason we don't just call:
+		//     return f.RangeBetween(fieldName, predicates[0], predicates[1])
+		// here is because we need the call to be shard-specific.
+
+		// Find bsiGroup.
+		bsig := f.bsiGroup(fieldName)
+		if bsig == nil {
+			return nil, ErrBSIGroupNotFound
+		}
+
+		// Convert the values to the integers stored in the bsiGroup.
+		predicates := make([]int64, len(values))
+		for i := range values {
+			v, err := bsig.scaledValue(values[i])
+			if err != nil {
+				return nil, errors.Wrap(err, "getting condition value")
+			}
+			predicates[i] = v
+		}
+
+		baseValueMin, baseValueMax, outOfRange := bsig.baseValueBetween(predicates[0], predicates[1])
+		if outOfRange {
+			return NewRow(), nil
+		}
+
+		// Retrieve fragment.
+		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+		if frag == nil {
+			return NewRow(), nil
+		}
+		callProfilerFrom(ctx).readRows(frag, 0, uint64(bsig.BitDepth())+1)
+
+		// If the query is asking for the entire valid range, just return
+		// the not-null bitmap for the bsiGroup.
+		if predicates[0] <= bsig.Min && predicates[1] >= bsig.Max {
+			return frag.notNull(bsig.BitDepth())
+		}
+
+		return frag.rangeBetween(bsig.BitDepth(), baseValueMin, baseValueMax)
+
+	} else {
+
+		// Find bsiGroup.
+		bsig := f.bsiGroup(fieldName)
+		if bsig == nil {
+			return nil, ErrBSIGroupNotFound
+		}
+
+		// Only support numeric values, which are converted to the integers
+		// stored in the bsiGroup.
+		value, err := bsig.scaledValue(cond.Value)
+		if errors.Cause(err) == ErrInvalidBSIGroupValueType {
+			return nil, errors.New("Range(): conditions only support integer values")
+		} else if err != nil {
+			return nil, errors.Wrap(err, "getting condition value")
+		}
+
+		baseValue, outOfRange := bsig.baseValue(cond.Op, value)
+		if outOfRange && cond.Op != pql.NEQ {
+			return NewRow(), nil
+		}
+
+		// Retrieve fragment.
+		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
+		if frag == nil {
+			return NewRow(), nil
+		}
+		callProfilerFrom(ctx).readRows(frag, 0, uint64(bsig.BitDepth())+1)
+
+		// LT[E] and GT[E] should return all not-null if selected range fully encompasses valid bsiGroup range.
+		if (cond.Op == pql.LT && value > bsig.Max) || (cond.Op == pql.LTE && value >= bsig.Max) ||
+			(cond.Op == pql.GT && value < bsig.Min) || (cond.Op == pql.GTE && value <= bsig.Min) {
+			return frag.notNull(bsig.BitDepth())
+		}
+
+		// outOfRange for NEQ should return all not-null.
+		if outOfRange && cond.Op == pql.NEQ {
+			return frag.notNull(bsig.BitDepth())
+		}
+
+		f.Stats.Count("range:bsigroup", 1, 1.0)
+		return frag.rangeOp(cond.Op, bsig.BitDepth(), baseValue)
+	}
+}
+
+// executeUnionShard executes a union() call for a local shard.
+func (e *executor) executeUnionShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	other := NewRow()
+	for i, input := range c.Children {
+		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			other = row
+		} else {
+			other = other.Union(row)
+		}
+	}
+	other.InvalidateCount()
+	return other, nil
+}
+
+// executeXorShard executes a xor() call for a local shard.
+func (e *executor) executeXorShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	other := NewRow()
+	for i, input := range c.Children {
+		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			other = row
+		} else {
+			other = other.Xor(row)
+		}
+	}
+	other.InvalidateCount()
+	return other, nil
+}
+
+// executeShiftShard executes a shift() call for a local shard. Bits shifted
+// past the edge of the shard are returned in the neighbouring shard's segment.
+func (e *executor) executeShiftShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	if len(c.Children) != 1 {
+		return nil, errors.New("Shift() requires a single row input")
+	}
+
+	n, ok := c.Args["n"].(int64)
+	if !ok {
+		return nil, errors.New("Shift() requires an integer n argument")
+	}
+
+	row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+	if err != nil {
+		return nil, err
+	}
+	return row.Shift(n), nil
+}
+
+// executeNotShard executes a not() call for a local shard.
+func (e *executor) executeNotShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
+	if len(c.Children) == 0 {
+		return nil, errors.New("Not() requires an input row")
+	} else if len(c.Children) > 1 {
+		return nil, errors.New("Not() only accepts a single row input")
+	}
+
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return nil, ErrIndexNotFound
+	} else if !idx.TrackExistence() {
+		return nil, ErrIndexExistenceNotTracked
+	}
+
+	// Start with every column that exists in the shard.
+	existenceRow := NewRow()
+	if frag := e.Holder.fragment(index, existenceFieldName, ViewStandard, shard); frag != nil {
+		existenceRow = fragmentRow(ctx, frag, 0)
+	}
+
+	row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+	if err != nil {
+		return nil, err
+	}
+
+	return existenceRow.Difference(row), nil
+}
+
+// executeCount executes a count() call.
+func (e *executor) executeCount(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (uint64, error) {
+	if len(c.Children) == 0 {
+		return 0, errors.New("Count() requires an input bitmap")
+	} else if len(c.Children) > 1 {
+		return 0, errors.New("Count() only accepts a single bitmap input")
+	}
+
+	// Bits carried by a shift into a neighbouring shard may already be set
+	// in that shard, so per-shard counts can't simply be added. Merge the
+	// rows at the coordinator and count them once instead.
+	if hasShift(c.Children[0]) {
+		row, err := e.executeBitmapCall(ctx, index, c.Children[0], shards, &execOptions{Remote: opt.Remote, ExcludeRowAttrs: true})
+		if err != nil {
+			return 0, err
+		}
+		return row.Count(), nil
+	}
+
+	// Execute calls in bulk on each remote node and merge.
+	mapFn := func(shard uint64) (interface{}, error) {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+		if err != nil {
+			return 0, err
+		}
+		return row.Count(), nil
+	}
+
+	// Merge returned results at coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.(uint64)
+		return other + v.(uint64)
+	}
+
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := result.(uint64)
+
+	return n, nil
+}
+
+// executeCountByTime executes a CountByTime() call. It returns the count of a
+// time field's row in each unit sized bucket between the from and to times,
+// reading the view which the field maintains for each bucket.
+func (e *executor) executeCountByTime(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) ([]TimeCount, error) {
+	if len(c.Children) != 1 || c.Children[0].Name != "Row" {
+		return nil, errors.New("CountByTime() requires a single Row() input")
+	}
+	child := c.Children[0]
+
+	fieldName, err := child.FieldArg()
+	if err != nil {
+		return nil, errors.New("CountByTime() Row() argument required: field")
+	}
+	f := e.Holder.Field(index, fieldName)
+	if f == nil {
+		return nil, ErrFieldNotFound
+	}
+	rowID, ok, err := child.UintArg(fieldName)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading row")
+	} else if !ok {
+		return nil, fmt.Errorf("CountByTime() Row() must specify %v", rowLabel)
+	}
+
+	unit := callArgString(c, "unit")
+	if len(unit) != 1 || !strings.Contains("YMDHT", unit) {
+		return nil, errors.New("CountByTime() unit must be one of Y, M, D, H or T")
+	} else if !strings.Contains(string(f.TimeQuantum()), unit) {
+		return nil, fmt.Errorf("CountByTime() unit %s is not in the time quantum of field %s", unit, fieldName)
+	}
+
+	startTimeStr, hasStart := c.Args["_start"].(string)
+	endTimeStr, hasEnd := c.Args["_end"].(string)
+	if !hasStart || !hasEnd {
+		return nil, errors.New("CountByTime() from and to times required")
+	}
+	startTime, err := time.Parse(TimeFormat, startTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse CountByTime() from time")
+	}
+	endTime, err := time.Parse(TimeFormat, endTimeStr)
+	if err != nil {
+		return nil, errors.New("cannot parse CountByTime() to time")
 	}
 
-	// Parse start time.
-	startTimeStr, ok := c.Args["_start"].(string)
+	// Buckets follow the field's time zone, in which its views are named.
+	loc := f.timeLocation()
+	buckets, ok := timeBuckets(wallTime(startTime, loc), wallTime(endTime, loc), rune(unit[0]), e.MaxTimeBuckets)
 	if !ok {
-		return nil, errors.New("Range() start time required")
+		return nil, fmt.Errorf("CountByTime() range has more than %d buckets of unit %s", e.MaxTimeBuckets, unit)
 	}
-	startTime, err := time.Parse(TimeFormat, startTimeStr)
+	newCounts := func() []TimeCount {
+		counts := make([]TimeCount, len(buckets))
+		for i, t := range buckets {
+			counts[i].Time = fromWallTime(t, loc)
+		}
+		return counts
+	}
+
+	// Count the row in each bucket's view for every shard.
+	mapFn := func(shard uint64) (interface{}, error) {
+		counts := newCounts()
+		for i, t := range buckets {
+			frag := e.Holder.fragment(index, fieldName, viewByTimeUnit(ViewStandard, t, rune(unit[0])), shard)
+			if frag == nil {
+				continue
+			}
+			counts[i].Count = fragmentRow(ctx, frag, rowID).Count()
+		}
+		return counts, nil
+	}
+
+	// Sum the counts of each bucket at the coordinating node.
+	reduceFn := func(prev, v interface{}) interface{} {
+		other, _ := prev.([]TimeCount)
+		counts := v.([]TimeCount)
+		if len(other) == 0 {
+			return counts
+		} else if len(counts) == 0 {
+			return other
+		}
+		for i := range other {
+			other[i].Count += counts[i].Count
+		}
+		return other
+	}
+
+	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
 	if err != nil {
-		return nil, errors.New("cannot parse Range() start time")
+		return nil, err
+	}
+	counts, _ := result.([]TimeCount)
+	if len(counts) == 0 {
+		return newCounts(), nil
 	}
 
-	// Parse end time.
-	endTimeStr, ok := c.Args["_end"].(string)
-	if !ok {
-		return nil, errors.New("Range() end time required")
+	// Counts decoded from remote nodes carry their times in UTC.
+	for i := range counts {
+		counts[i].Time = fromWallTime(buckets[i], loc)
 	}
-	endTime, err := time.Parse(TimeFormat, endTimeStr)
+	return counts, nil
+}
+
+// executeClearBit executes a Clear() call.
+func (e *executor) executeClearBit(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
+	fieldName, err := c.FieldArg()
 	if err != nil {
-		return nil, errors.New("cannot parse Range() end time")
+		return false, errors.New("Clear() argument required: field")
 	}
 
-	// If no quantum exists then return an empty bitmap.
-	q := f.TimeQuantum()
-	if q == "" {
-		return &Row{}, nil
+	// Retrieve field.
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return false, ErrIndexNotFound
+	}
+	f := idx.Field(fieldName)
+	if f == nil {
+		return false, ErrFieldNotFound
 	}
 
-	// Union bitmaps across all time-based views.
-	row := &Row{}
-	for _, view := range viewsByTimeRange(ViewStandard, startTime, endTime, q) {
-		f := e.Holder.fragment(index, fieldName, view, shard)
-		if f == nil {
+	// Read fields using labels.
+	rowID, ok, err := c.UintArg(fieldName)
+	if err != nil {
+		return false, fmt.Errorf("reading Clear() row: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("Clear() row argument '%v' required", rowLabel)
+	}
+
+	colID, ok, err := c.UintArg("_" + columnLabel)
+	if err != nil {
+		return false, fmt.Errorf("reading Clear() column: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("Clear() col argument '%v' required", columnLabel)
+	}
+
+	return e.executeClearBitField(ctx, index, c, f, colID, rowID, opt)
+}
+
+// executeClearBitField executes a Clear() call for a single view.
+func (e *executor) executeClearBitField(ctx context.Context, index string, c *pql.Call, f *Field, colID, rowID uint64, opt *execOptions) (bool, error) {
+	shard := colID / ShardWidth
+	ret := false
+	for _, node := range e.Cluster.shardNodes(index, shard) {
+		// Update locally if host matches.
+		if node.ID == e.Node.ID {
+			mu := e.conditionLocks.lock(index, f.Name(), shard)
+			mu.RLock()
+			val, err := f.ClearBit(rowID, colID)
+			mu.RUnlock()
+			if err != nil {
+				return false, err
+			} else if val {
+				ret = true
+			}
+			continue
+		}
+		// Do not forward call if this is already being forwarded.
+		if opt.Remote {
 			continue
 		}
-		row = row.Union(f.row(rowID))
+
+		// Forward call to remote node otherwise.
+		if res, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
+			return false, err
+		} else {
+			ret = res[0].(bool)
+		}
 	}
-	f.Stats.Count("range", 1, 1.0)
-	return row, nil
+	return ret, nil
 }
 
-// executeBSIGroupRangeShard executes a range(bsiGroup) call for a local shard.
-func (e *executor) executeBSIGroupRangeShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	// Only one conditional should be present.
-	if len(c.Args) == 0 {
-		return nil, errors.New("Range(): condition required")
-	} else if len(c.Args) > 1 {
-		return nil, errors.New("Range(): too many arguments")
+// executeSetBit executes a Set() call.
+func (e *executor) executeSetBit(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
+	fieldName, err := c.FieldArg()
+	if err != nil {
+		return false, errors.New("Set() argument required: field")
 	}
 
-	// Extract conditional.
-	var fieldName string
-	var cond *pql.Condition
-	for k, v := range c.Args {
-		vv, ok := v.(*pql.Condition)
-		if !ok {
-			return nil, fmt.Errorf("Range(): %q: expected condition argument, got %v", k, v)
-		}
-		fieldName, cond = k, vv
+	// Retrieve field.
+	idx := e.Holder.Index(index)
+	if idx == nil {
+		return false, ErrIndexNotFound
 	}
-
-	f := e.Holder.Field(index, fieldName)
+	f := idx.Field(fieldName)
 	if f == nil {
-		return nil, ErrFieldNotFound
+		return false, ErrFieldNotFound
 	}
 
-	// EQ null           (not implemented: flip frag.NotNull with max ColumnID)
-	// NEQ null          frag.NotNull()
-	// BETWEEN a,b(in)   BETWEEN/frag.RangeBetween()
-	// BETWEEN a,b(out)  BETWEEN/frag.NotNull()
-	// EQ <int>          frag.RangeOp
-	// NEQ <int>         frag.RangeOp
+	// Read fields using labels.
+	rowID, ok, err := c.UintArg(fieldName)
+	if err != nil {
+		return false, fmt.Errorf("reading Set() row: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("Set() row argument '%v' required", rowLabel)
+	}
 
-	// Handle `!= null`.
-	if cond.Op == pql.NEQ && cond.Value == nil {
-		// Find bsiGroup.
-		bsig := f.bsiGroup(fieldName)
-		if bsig == nil {
-			return nil, ErrBSIGroupNotFound
+	colID, ok, err := c.UintArg("_" + columnLabel)
+	if err != nil {
+		return false, fmt.Errorf("reading Set() column: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("Set() column argument '%v' required", columnLabel)
+	}
+
+	var timestamp *time.Time
+	sTimestamp, ok := c.Args["_timestamp"].(string)
+	if ok {
+		// A bare integer is a Unix timestamp in seconds.
+		format := TimeFormat
+		if strings.Trim(sTimestamp, "0123456789") == "" {
+			format = TimestampFormatEpoch
+		}
+		t, err := ParseTimestamp(sTimestamp, format)
+		if err != nil {
+			return false, fmt.Errorf("invalid date: %s: %v", sTimestamp, err)
 		}
+		timestamp = &t
+	}
 
-		// Retrieve fragment.
-		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-		if frag == nil {
-			return NewRow(), nil
+	if _, ok := c.Args["ifValue"]; ok {
+		return false, errors.New("Set(): ifValue only applies to SetValue()")
+	}
+	cond, err := parseWriteCondition(c)
+	if err != nil {
+		return false, errors.Wrap(err, "reading Set() condition")
+	} else if cond != nil {
+		if cf := idx.Field(cond.field); cf == nil {
+			return false, ErrFieldNotFound
+		} else if cf.Type() == FieldTypeInt {
+			return false, fmt.Errorf("Set(): condition field '%s' cannot be an int field", cond.field)
 		}
+	}
 
-		return frag.notNull(bsig.BitDepth())
+	return e.executeSetBitField(ctx, index, c, f, colID, rowID, timestamp, cond, opt)
+}
 
-	} else if cond.Op == pql.BETWEEN {
+// writeCondition is a precondition on a row of the column being written,
+// given as "field:row" to the ifSet or ifNotSet argument of Set().
+type writeCondition struct {
+	field string
+	rowID uint64
+	set   bool // whether the bit must be set for the write to apply
+}
 
-		predicates, err := cond.IntSliceValue()
-		if err != nil {
-			return nil, errors.Wrap(err, "getting condition value")
-		}
+// conditionLocks holds a lock for each fragment written on this node, by
+// index, field and shard. Set() and Clear() hold the read lock of the fragment
+// they write, and a guarded Set() also holds the write lock of the fragment
+// its precondition reads. Imports don't take these locks.
+type conditionLocks struct {
+	mu    sync.Mutex
+	locks map[conditionKey]*sync.RWMutex
+}
 
-		// Only support two integers for the between operation.
-		if len(predicates) != 2 {
-			return nil, errors.New("Range(): BETWEEN condition requires exactly two integer values")
-		}
+// conditionKey identifies the fragment of a conditionLocks lock.
+type conditionKey struct {
+	index string
+	field string
+	shard uint64
+}
 
-		// The reason we don't just call:
-		//     return f.RangeBetween(fieldName, predicates[0], predicates[1])
-		// here is because we need the call to be shard-specific.
+// lock returns the lock of a fragment, creating it if necessary.
+func (l *conditionLocks) lock(index, field string, shard uint64) *sync.RWMutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-		// Find bsiGroup.
-		bsig := f.bsiGroup(fieldName)
-		if bsig == nil {
-			return nil, ErrBSIGroupNotFound
+	key := conditionKey{index: index, field: field, shard: shard}
+	mu := l.locks[key]
+	if mu == nil {
+		if l.locks == nil {
+			l.locks = make(map[conditionKey]*sync.RWMutex)
 		}
+		mu = &sync.RWMutex{}
+		l.locks[key] = mu
+	}
+	return mu
+}
 
-		baseValueMin, baseValueMax, outOfRange := bsig.baseValueBetween(predicates[0], predicates[1])
-		if outOfRange {
-			return NewRow(), nil
+// parseWriteCondition returns the precondition of a call, or nil if it has none.
+func parseWriteCondition(c *pql.Call) (*writeCondition, error) {
+	var cond *writeCondition
+	for _, arg := range []string{"ifSet", "ifNotSet"} {
+		v, ok := c.Args[arg]
+		if !ok {
+			continue
+		} else if cond != nil {
+			return nil, errors.New("only one of ifSet and ifNotSet may be given")
 		}
 
-		// Retrieve fragment.
-		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-		if frag == nil {
-			return NewRow(), nil
+		s, _ := v.(string)
+		i := strings.LastIndex(s, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("%s must be of the form \"field:row\"", arg)
 		}
-
-		// If the query is asking for the entire valid range, just return
-		// the not-null bitmap for the bsiGroup.
-		if predicates[0] <= bsig.Min && predicates[1] >= bsig.Max {
-			return frag.notNull(bsig.BitDepth())
+		rowID, err := strconv.ParseUint(s[i+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s row must be an unsigned integer: %s", arg, s[i+1:])
 		}
+		cond = &writeCondition{field: s[:i], rowID: rowID, set: arg == "ifSet"}
+	}
+	return cond, nil
+}
 
-		return frag.rangeBetween(bsig.BitDepth(), baseValueMin, baseValueMax)
+// executeSetBitField executes a Set() call for a specific view. If cond is
+// not nil, the bit is only set when the precondition holds and the result is
+// whether the write was applied rather than whether it changed the bit.
+func (e *executor) executeSetBitField(ctx context.Context, index string, c *pql.Call, f *Field, colID, rowID uint64, timestamp *time.Time, cond *writeCondition, opt *execOptions) (bool, error) {
+	shard := colID / ShardWidth
+	if cond != nil {
+		return e.executeGuardedWrite(ctx, index, c, shard, opt, func(check bool) (bool, error) {
+			if !check {
+				return e.setBitIf(index, f, colID, rowID, timestamp, nil)
+			}
+			return e.setBitIf(index, f, colID, rowID, timestamp, cond)
+		})
+	}
 
-	} else {
+	ret := false
 
-		// Only support integers for now.
-		value, ok := cond.Value.(int64)
-		if !ok {
-			return nil, errors.New("Range(): conditions only support integer values")
+	for _, node := range e.Cluster.shardNodes(index, shard) {
+		// Update locally if host matches.
+		if node.ID == e.Node.ID {
+			val, err := e.setBitIf(index, f, colID, rowID, timestamp, cond)
+			if err != nil {
+				return false, err
+			} else if val {
+				ret = true
+			}
+			continue
 		}
 
-		// Find bsiGroup.
-		bsig := f.bsiGroup(fieldName)
-		if bsig == nil {
-			return nil, ErrBSIGroupNotFound
+		// Do not forward call if this is already being forwarded.
+		if opt.Remote {
+			continue
 		}
 
-		baseValue, outOfRange := bsig.baseValue(cond.Op, value)
-		if outOfRange && cond.Op != pql.NEQ {
-			return NewRow(), nil
+		// Forward call to remote node otherwise.
+		if res, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
+			return false, err
+		} else {
+			ret = res[0].(bool)
 		}
+	}
+	return ret, nil
+}
 
-		// Retrieve fragment.
-		frag := e.Holder.fragment(index, fieldName, viewBSIGroupPrefix+fieldName, shard)
-		if frag == nil {
-			return NewRow(), nil
+// executeGuardedWrite executes a write call guarded by a precondition on the
+// nodes owning shard. The precondition is only checked by the primary owner of
+// the shard and, if it holds, the write is forwarded to the replicas without
+// it, so that replicas cannot decide differently. apply executes the call on
+// the local node, checking the precondition if check is true.
+func (e *executor) executeGuardedWrite(ctx context.Context, index string, c *pql.Call, shard uint64, opt *execOptions, apply func(check bool) (bool, error)) (bool, error) {
+	// A forwarded guarded call is only sent to the primary.
+	if opt.Remote {
+		return apply(true)
+	}
+
+	nodes := e.Cluster.shardNodes(index, shard)
+	if len(nodes) == 0 {
+		return false, nil
+	}
+
+	// Decide the write on the primary.
+	var applied bool
+	if nodes[0].ID == e.Node.ID {
+		ok, err := apply(true)
+		if err != nil {
+			return false, err
 		}
+		applied = ok
+	} else {
+		res, err := e.remoteExec(ctx, nodes[0], index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt)
+		if err != nil {
+			return false, err
+		}
+		applied = res[0].(bool)
+	}
+	if !applied {
+		return false, nil
+	}
 
-		// LT[E] and GT[E] should return all not-null if selected range fully encompasses valid bsiGroup range.
-		if (cond.Op == pql.LT && value > bsig.Max) || (cond.Op == pql.LTE && value >= bsig.Max) ||
-			(cond.Op == pql.GT && value < bsig.Min) || (cond.Op == pql.GTE && value <= bsig.Min) {
-			return frag.notNull(bsig.BitDepth())
+	// Apply the decided write to the replicas.
+	unguarded := c.Clone()
+	for arg := range unguarded.Args {
+		if pql.IsConditionArg(arg) {
+			delete(unguarded.Args, arg)
+		}
+	}
+	for _, node := range nodes[1:] {
+		if node.ID == e.Node.ID {
+			if _, err := apply(false); err != nil {
+				return false, err
+			}
+			continue
 		}
-
-		// outOfRange for NEQ should return all not-null.
-		if outOfRange && cond.Op == pql.NEQ {
-			return frag.notNull(bsig.BitDepth())
+		if _, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{unguarded}}, nil, opt); err != nil {
+			return false, err
 		}
-
-		f.Stats.Count("range:bsigroup", 1, 1.0)
-		return frag.rangeOp(cond.Op, bsig.BitDepth(), baseValue)
 	}
+	return true, nil
 }
 
-// executeUnionShard executes a union() call for a local shard.
-func (e *executor) executeUnionShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	other := NewRow()
-	for i, input := range c.Children {
-		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
-		if err != nil {
-			return nil, err
+// setBitIf sets a bit on the local node if cond holds, or unconditionally if
+// cond is nil, and marks the column as existing.
+func (e *executor) setBitIf(index string, f *Field, colID, rowID uint64, timestamp *time.Time, cond *writeCondition) (bool, error) {
+	shard := colID / ShardWidth
+	mu := e.conditionLocks.lock(index, f.Name(), shard)
+	if cond == nil {
+		mu.RLock()
+		defer mu.RUnlock()
+	} else {
+		// Locks are taken in order of field name so that guarded writes
+		// conditioned on each other's fields cannot deadlock.
+		condMu := e.conditionLocks.lock(index, cond.field, shard)
+		switch {
+		case cond.field == f.Name():
+			condMu.Lock()
+			defer condMu.Unlock()
+		case cond.field < f.Name():
+			condMu.Lock()
+			defer condMu.Unlock()
+			mu.RLock()
+			defer mu.RUnlock()
+		default:
+			mu.RLock()
+			defer mu.RUnlock()
+			condMu.Lock()
+			defer condMu.Unlock()
 		}
 
-		if i == 0 {
-			other = row
-		} else {
-			other = other.Union(row)
+		cf := e.Holder.Field(index, cond.field)
+		if cf == nil {
+			return false, ErrFieldNotFound
+		}
+		if set, err := cf.Bit(cond.rowID, colID); err != nil {
+			return false, errors.Wrap(err, "checking condition")
+		} else if set != cond.set {
+			return false, nil
 		}
 	}
-	other.InvalidateCount()
-	return other, nil
-}
 
-// executeXorShard executes a xor() call for a local shard.
-func (e *executor) executeXorShard(ctx context.Context, index string, c *pql.Call, shard uint64) (*Row, error) {
-	other := NewRow()
-	for i, input := range c.Children {
-		row, err := e.executeBitmapCallShard(ctx, index, input, shard)
-		if err != nil {
-			return nil, err
-		}
+	changed, err := f.SetBit(rowID, colID, timestamp)
+	if err != nil {
+		return false, err
+	}
 
-		if i == 0 {
-			other = row
-		} else {
-			other = other.Xor(row)
+	// Mark the column as existing.
+	if ef := e.Holder.Index(index).existenceField(); ef != nil {
+		if _, err := ef.SetBit(0, colID, nil); err != nil {
+			return false, errors.Wrap(err, "setting existence bit")
 		}
 	}
-	other.InvalidateCount()
-	return other, nil
+	return changed || cond != nil, nil
 }
 
-// executeCount executes a count() call.
-func (e *executor) executeCount(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (uint64, error) {
-	if len(c.Children) == 0 {
-		return 0, errors.New("Count() requires an input bitmap")
-	} else if len(c.Children) > 1 {
-		return 0, errors.New("Count() only accepts a single bitmap input")
+// executeSetValue executes a SetValue() call.
+func (e *executor) executeSetValue(ctx context.Context, index string, c *pql.Call, opt *execOptions) error {
+	// Parse labels.
+	columnID, ok, err := c.UintArg(columnLabel)
+	if err != nil {
+		return fmt.Errorf("reading SetValue() column: %v", err)
+	} else if !ok {
+		return fmt.Errorf("SetValue() column field '%v' required", columnLabel)
 	}
 
-	// Execute calls in bulk on each remote node and merge.
-	mapFn := func(shard uint64) (interface{}, error) {
-		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
+	// Copy args and remove reserved fields.
+	args := pql.CopyArgs(c.Args)
+	// While field could technically work as a ColumnAttr argument, we are treating it as a reserved word primarily to avoid confusion.
+	// Also, if we ever need to make ColumnAttrs field-specific, then having this reserved word prevents backward incompatibility.
+	delete(args, columnLabel)
+
+	// Set values.
+	for name, value := range args {
+		if pql.IsConditionArg(name) {
+			return fmt.Errorf("SetValue(): %s only applies to Set()", name)
+		}
+
+		// Retrieve field.
+		field := e.Holder.Field(index, name)
+		if field == nil {
+			return ErrFieldNotFound
+		}
+
+		bsig := field.bsiGroup(name)
+		if bsig == nil {
+			return ErrBSIGroupNotFound
+		}
+
+		v, err := bsig.scaledValue(value)
 		if err != nil {
-			return 0, err
+			return err
+		} else if _, err := field.SetValue(columnID, v); err != nil {
+			return err
 		}
-		return row.Count(), nil
+		field.Stats.Count("SetValue", 1, 1.0)
 	}
 
-	// Merge returned results at coordinating node.
-	reduceFn := func(prev, v interface{}) interface{} {
-		other, _ := prev.(uint64)
-		return other + v.(uint64)
+	// Mark the column as existing.
+	if ef := e.Holder.Index(index).existenceField(); ef != nil {
+		if _, err := ef.SetBit(0, columnID, nil); err != nil {
+			return errors.Wrap(err, "setting existence bit")
+		}
 	}
 
-	result, err := e.mapReduce(ctx, index, shards, c, opt, mapFn, reduceFn)
-	if err != nil {
-		return 0, err
+	// Do not forward call if this is already being forwarded.
+	if opt.Remote {
+		return nil
 	}
-	n, _ := result.(uint64)
 
-	return n, nil
+	// Execute on remote nodes in parallel.
+	nodes := Nodes(e.Cluster.Nodes).FilterID(e.Node.ID)
+	resp := make(chan error, len(nodes))
+	for _, node := range nodes {
+		go func(node *Node) {
+			_, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt)
+			resp <- err
+		}(node)
+	}
+
+	// Return first error.
+	for range nodes {
+		if err := <-resp; err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// executeClearBit executes a Clear() call.
-func (e *executor) executeClearBit(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
-	fieldName, err := c.FieldArg()
+// executeSetValueIf executes a SetValue() call with an ifValue precondition.
+// The value is set on the nodes owning the column's shard if the column holds
+// the ifValue value on the primary, or holds no value if ifValue is null.
+// Returns true if the value was set.
+func (e *executor) executeSetValueIf(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
+	// Parse labels.
+	columnID, ok, err := c.UintArg(columnLabel)
 	if err != nil {
-		return false, errors.New("Clear() argument required: field")
+		return false, fmt.Errorf("reading SetValue() column: %v", err)
+	} else if !ok {
+		return false, fmt.Errorf("SetValue() column field '%v' required", columnLabel)
 	}
 
-	// Retrieve field.
-	idx := e.Holder.Index(index)
-	if idx == nil {
-		return false, ErrIndexNotFound
+	// Copy args and remove reserved fields, leaving the field to set.
+	args := pql.CopyArgs(c.Args)
+	delete(args, columnLabel)
+	delete(args, "ifValue")
+	if len(args) != 1 {
+		return false, errors.New("SetValue(): ifValue requires exactly one field")
 	}
-	f := idx.Field(fieldName)
-	if f == nil {
+
+	var name string
+	var value interface{}
+	for k, v := range args {
+		name, value = k, v
+	}
+	if pql.IsConditionArg(name) {
+		return false, fmt.Errorf("SetValue(): %s only applies to Set()", name)
+	}
+
+	// Retrieve field.
+	field := e.Holder.Field(index, name)
+	if field == nil {
 		return false, ErrFieldNotFound
 	}
+	bsig := field.bsiGroup(name)
+	if bsig == nil {
+		return false, ErrBSIGroupNotFound
+	}
 
-	// Read fields using labels.
-	rowID, ok, err := c.UintArg(fieldName)
+	v, err := bsig.scaledValue(value)
 	if err != nil {
-		return false, fmt.Errorf("reading Clear() row: %v", err)
-	} else if !ok {
-		return false, fmt.Errorf("Clear() row argument '%v' required", rowLabel)
+		return false, err
+	}
+	var expected *int64
+	if ifValue := c.Args["ifValue"]; ifValue != nil {
+		ev, err := bsig.scaledValue(ifValue)
+		if err != nil {
+			return false, errors.Wrap(err, "reading SetValue() ifValue")
+		}
+		expected = &ev
 	}
 
-	colID, ok, err := c.UintArg("_" + columnLabel)
+	return e.executeGuardedWrite(ctx, index, c, columnID/ShardWidth, opt, func(check bool) (bool, error) {
+		if check {
+			if applied, err := field.SetValueIf(columnID, v, expected); err != nil || !applied {
+				return false, err
+			}
+		} else if _, err := field.SetValue(columnID, v); err != nil {
+			return false, err
+		}
+		field.Stats.Count("SetValue", 1, 1.0)
+
+		// Mark the column as existing.
+		if ef := e.Holder.Index(index).existenceField(); ef != nil {
+			if _, err := ef.SetBit(0, columnID, nil); err != nil {
+				return false, errors.Wrap(err, "setting existence bit")
+			}
+		}
+		return true, nil
+	})
+}
+
+// executeClearValue executes a ClearValue() call.
+func (e *executor) executeClearValue(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
+	// Parse labels.
+	columnID, ok, err := c.UintArg(columnLabel)
 	if err != nil {
-		return false, fmt.Errorf("reading Clear() column: %v", err)
+		return false, fmt.Errorf("reading ClearValue() column: %v", err)
 	} else if !ok {
-		return false, fmt.Errorf("Clear() col argument '%v' required", columnLabel)
+		return false, fmt.Errorf("ClearValue() column field '%v' required", columnLabel)
 	}
 
-	return e.executeClearBitField(ctx, index, c, f, colID, rowID, opt)
-}
+	fieldName := callArgString(c, "field")
+	if fieldName == "" {
+		return false, errors.New("ClearValue(): field required")
+	}
 
-// executeClearBitField executes a Clear() call for a single view.
-func (e *executor) executeClearBitField(ctx context.Context, index string, c *pql.Call, f *Field, colID, rowID uint64, opt *execOptions) (bool, error) {
-	shard := colID / ShardWidth
+	// Retrieve field.
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return false, ErrFieldNotFound
+	} else if field.bsiGroup(fieldName) == nil {
+		return false, ErrBSIGroupNotFound
+	}
+
+	shard := columnID / ShardWidth
 	ret := false
 	for _, node := range e.Cluster.shardNodes(index, shard) {
 		// Update locally if host matches.
 		if node.ID == e.Node.ID {
-			val, err := f.ClearBit(rowID, colID)
+			val, err := field.ClearValue(columnID)
 			if err != nil {
 				return false, err
 			} else if val {
 				ret = true
 			}
+			field.Stats.Count("ClearValue", 1, 1.0)
 			continue
 		}
 		// Do not forward call if this is already being forwarded.
@@ -1053,148 +2749,192 @@ func (e *executor) executeClearBitField(ctx context.Context, index string, c *pq
 		// Forward call to remote node otherwise.
 		if res, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
 			return false, err
-		} else {
-			ret = res[0].(bool)
+		} else if res[0].(bool) {
+			ret = true
 		}
 	}
 	return ret, nil
 }
 
-// executeSetBit executes a Set() call.
-func (e *executor) executeSetBit(ctx context.Context, index string, c *pql.Call, opt *execOptions) (bool, error) {
+// executeStore executes a Store() call. The child row is computed and written
+// to the target row separately on every node owning each shard, so that all
+// replicas are replaced rather than only the primary.
+func (e *executor) executeStore(ctx context.Context, index string, c *pql.Call, shards []uint64, opt *execOptions) (bool, error) {
 	fieldName, err := c.FieldArg()
 	if err != nil {
-		return false, errors.New("Set() argument required: field")
-	}
-
-	// Retrieve field.
-	idx := e.Holder.Index(index)
-	if idx == nil {
-		return false, ErrIndexNotFound
-	}
-	f := idx.Field(fieldName)
-	if f == nil {
-		return false, ErrFieldNotFound
+		return false, errors.New("Store() argument required: field")
+	} else if len(c.Children) != 1 {
+		return false, errors.New("Store() requires a single bitmap input")
 	}
 
-	// Read fields using labels.
 	rowID, ok, err := c.UintArg(fieldName)
 	if err != nil {
-		return false, fmt.Errorf("reading Set() row: %v", err)
+		return false, fmt.Errorf("reading Store() row: %v", err)
 	} else if !ok {
-		return false, fmt.Errorf("Set() row argument '%v' required", rowLabel)
+		return false, fmt.Errorf("Store() row argument '%v' required", rowLabel)
 	}
 
-	colID, ok, err := c.UintArg("_" + columnLabel)
-	if err != nil {
-		return false, fmt.Errorf("reading Set() column: %v", err)
-	} else if !ok {
-		return false, fmt.Errorf("Set() column argument '%v' required", columnLabel)
+	// Retrieve field.
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return false, ErrFieldNotFound
+	} else if field.Type() != FieldTypeSet {
+		return false, fmt.Errorf("Store() cannot write to %s field", field.Type())
 	}
 
-	var timestamp *time.Time
-	sTimestamp, ok := c.Args["_timestamp"].(string)
-	if ok {
-		t, err := time.Parse(TimeFormat, sTimestamp)
+	mapFn := func(shard uint64) (interface{}, error) {
+		row, err := e.executeBitmapCallShard(ctx, index, c.Children[0], shard)
 		if err != nil {
-			return false, fmt.Errorf("invalid date: %s", sTimestamp)
+			return false, err
 		}
-		timestamp = &t
+		return field.SetRow(shard, rowID, row)
+	}
+	reduceFn := func(prev, v interface{}) interface{} {
+		changed, _ := prev.(bool)
+		return changed || v.(bool)
 	}
 
-	return e.executeSetBitField(ctx, index, c, f, colID, rowID, timestamp, opt)
-}
+	// Only store the shards this node was sent if the call was forwarded.
+	if opt.Remote {
+		result, err := e.mapperLocal(ctx, shards, mapFn, reduceFn)
+		changed, _ := result.(bool)
+		return changed, err
+	}
 
-// executeSetBitField executes a Set() call for a specific view.
-func (e *executor) executeSetBitField(ctx context.Context, index string, c *pql.Call, f *Field, colID, rowID uint64, timestamp *time.Time, opt *execOptions) (bool, error) {
-	shard := colID / ShardWidth
-	ret := false
+	// Group shards by every owning node.
+	m := make(map[*Node][]uint64)
+	for _, shard := range shards {
+		for _, node := range e.Cluster.shardNodes(index, shard) {
+			m[node] = append(m[node], shard)
+		}
+	}
 
-	for _, node := range e.Cluster.shardNodes(index, shard) {
-		// Update locally if host matches.
+	ret := false
+	for node, nodeShards := range m {
+		var result interface{}
 		if node.ID == e.Node.ID {
-			val, err := f.SetBit(rowID, colID, timestamp)
-			if err != nil {
+			if result, err = e.mapperLocal(ctx, nodeShards, mapFn, reduceFn); err != nil {
 				return false, err
-			} else if val {
-				ret = true
 			}
-			continue
-		}
-
-		// Do not forward call if this is already being forwarded.
-		if opt.Remote {
-			continue
-		}
-
-		// Forward call to remote node otherwise.
-		if res, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt); err != nil {
+		} else if res, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nodeShards, opt); err != nil {
 			return false, err
 		} else {
-			ret = res[0].(bool)
+			result = res[0]
+		}
+		if changed, _ := result.(bool); changed {
+			ret = true
 		}
 	}
 	return ret, nil
 }
 
-// executeSetValue executes a SetValue() call.
-func (e *executor) executeSetValue(ctx context.Context, index string, c *pql.Call, opt *execOptions) error {
+// executeIncrement executes an Increment() call. The value is added on the
+// primary owner of the column's shard, under the fragment's lock, and the
+// resulting value is set on the replicas. The new value is returned.
+func (e *executor) executeIncrement(ctx context.Context, index string, c *pql.Call, opt *execOptions) (ValCount, error) {
 	// Parse labels.
 	columnID, ok, err := c.UintArg(columnLabel)
 	if err != nil {
-		return fmt.Errorf("reading SetValue() column: %v", err)
+		return ValCount{}, fmt.Errorf("reading Increment() column: %v", err)
 	} else if !ok {
-		return fmt.Errorf("SetValue() column field '%v' required", columnLabel)
+		return ValCount{}, fmt.Errorf("Increment() column field '%v' required", columnLabel)
 	}
 
-	// Copy args and remove reserved fields.
-	args := pql.CopyArgs(c.Args)
-	// While field could technically work as a ColumnAttr argument, we are treating it as a reserved word primarily to avoid confusion.
-	// Also, if we ever need to make ColumnAttrs field-specific, then having this reserved word prevents backward incompatibility.
-	delete(args, columnLabel)
+	fieldName := callArgString(c, "field")
+	if fieldName == "" {
+		return ValCount{}, errors.New("Increment(): field required")
+	}
+
+	// Retrieve field.
+	field := e.Holder.Field(index, fieldName)
+	if field == nil {
+		return ValCount{}, ErrFieldNotFound
+	}
+	bsig := field.bsiGroup(fieldName)
+	if bsig == nil {
+		return ValCount{}, ErrBSIGroupNotFound
+	}
 
-	// Set values.
-	for name, value := range args {
-		// Retrieve field.
-		field := e.Holder.Field(index, name)
-		if field == nil {
-			return ErrFieldNotFound
+	// Increment by one unless an amount is given.
+	delta := bsig.multiplier()
+	if by, ok := c.Args["by"]; ok {
+		if delta, err = bsig.scaledValue(by); err != nil {
+			return ValCount{}, errors.Wrap(err, "reading Increment() by")
 		}
+	}
 
-		switch value := value.(type) {
-		case int64:
-			if _, err := field.SetValue(columnID, value); err != nil {
-				return err
+	// markExists marks the column as existing on the local node.
+	markExists := func() error {
+		if ef := e.Holder.Index(index).existenceField(); ef != nil {
+			if _, err := ef.SetBit(0, columnID, nil); err != nil {
+				return errors.Wrap(err, "setting existence bit")
 			}
-		default:
-			return ErrInvalidBSIGroupValueType
 		}
-		field.Stats.Count("SetValue", 1, 1.0)
+		return nil
 	}
 
-	// Do not forward call if this is already being forwarded.
+	// increment adds the delta on the local node and returns the stored value.
+	increment := func() (ValCount, error) {
+		v, err := field.IncrementValue(columnID, delta)
+		if err != nil {
+			return ValCount{}, err
+		}
+		field.Stats.Count("Increment", 1, 1.0)
+		if err := markExists(); err != nil {
+			return ValCount{}, err
+		}
+		return ValCount{Val: v, Count: 1}, nil
+	}
+
+	// A forwarded increment is only sent to the primary, and remote nodes
+	// return the stored value for the coordinating node to convert.
 	if opt.Remote {
-		return nil
+		return increment()
 	}
 
-	// Execute on remote nodes in parallel.
-	nodes := Nodes(e.Cluster.Nodes).FilterID(e.Node.ID)
-	resp := make(chan error, len(nodes))
-	for _, node := range nodes {
-		go func(node *Node) {
-			_, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt)
-			resp <- err
-		}(node)
+	nodes := e.Cluster.shardNodes(index, columnID/ShardWidth)
+	if len(nodes) == 0 {
+		return ValCount{}, nil
 	}
 
-	// Return first error.
-	for range nodes {
-		if err := <-resp; err != nil {
-			return err
+	// Compute the new value on the primary.
+	var result ValCount
+	if nodes[0].ID == e.Node.ID {
+		if result, err = increment(); err != nil {
+			return ValCount{}, err
+		}
+	} else {
+		res, err := e.remoteExec(ctx, nodes[0], index, &pql.Query{Calls: []*pql.Call{c}}, nil, opt)
+		if err != nil {
+			return ValCount{}, err
 		}
+		result = res[0].(ValCount)
 	}
 
-	return nil
+	// Set the primary's value on the replicas rather than having each apply
+	// the delta, so that they cannot drift from the primary.
+	set := &pql.Call{
+		Name: "SetValue",
+		Args: map[string]interface{}{
+			columnLabel: columnID,
+			fieldName:   bsig.unscaledValue(result.Val),
+		},
+	}
+	for _, node := range nodes[1:] {
+		if node.ID == e.Node.ID {
+			if _, err := field.SetValue(columnID, result.Val); err != nil {
+				return ValCount{}, err
+			} else if err := markExists(); err != nil {
+				return ValCount{}, err
+			}
+			continue
+		}
+		if _, err := e.remoteExec(ctx, node, index, &pql.Query{Calls: []*pql.Call{set}}, nil, opt); err != nil {
+			return ValCount{}, err
+		}
+	}
+
+	return result.unscaled(bsig), nil
 }
 
 // executeSetRowAttrs executes a SetRowAttrs() call.
@@ -1391,17 +3131,32 @@ func (e *executor) executeSetColumnAttrs(ctx context.Context, index string, c *p
 
 // exec executes a PQL query remotely for a set of shards on a node.
 func (e *executor) remoteExec(ctx context.Context, node *Node, index string, q *pql.Query, shards []uint64, opt *execOptions) (results []interface{}, err error) {
+	p := callProfilerFrom(ctx)
+
 	// Encode request object.
 	pbreq := &internal.QueryRequest{
-		Query:  q.String(),
-		Shards: shards,
-		Remote: true,
+		Query:   q.String(),
+		Shards:  shards,
+		Remote:  true,
+		Profile: p != nil,
+	}
+
+	// Give the remote node what is left of the query's timeout so that it
+	// stops even if this node cannot cancel the request.
+	if deadline, ok := ctx.Deadline(); ok {
+		pbreq.Timeout = int64(time.Until(deadline))
+		if pbreq.Timeout <= 0 {
+			return nil, context.DeadlineExceeded
+		}
 	}
 
+	start := time.Now()
 	pb, err := e.client.QueryNode(ctx, &node.URI, index, pbreq)
+	p.addNodeTime(node.ID, time.Since(start))
 	if err != nil {
 		return nil, err
 	}
+	p.mergeRemote(node.ID, decodeCallProfiles(pb.Profile))
 
 	// Return an error, if specified on response.
 	if err := decodeError(pb.Err); err != nil {
@@ -1415,15 +3170,25 @@ func (e *executor) remoteExec(ctx context.Context, node *Node, index string, q *
 		var err error
 
 		switch call.Name {
-		case "Average", "Sum":
+		case "Average", "Sum", "Min", "Max", "Percentile", "Median", "PercentileCount", "Increment":
 			v, err = decodeValCount(pb.Results[i].GetValCount()), nil
 		case "TopN":
 			v, err = decodePairs(pb.Results[i].GetPairs()), nil
 		case "Count":
 			v, err = pb.Results[i].N, nil
+		case "Rows":
+			v, err = decodeRowIdentifiers(pb.Results[i].GetRowIdentifiers()), nil
+		case "GroupBy":
+			v, err = decodeGroupCounts(pb.Results[i].GetGroupCounts()), nil
+		case "CountByTime":
+			v, err = decodeTimeCounts(pb.Results[i].GetTimeCounts()), nil
+		case "TopK", "BottomK":
+			v, err = decodeColumnValues(pb.Results[i].GetColumnValues()), nil
+		case "Extract":
+			v, err = decodeExtractedTable(pb.Results[i].GetExtractedTable()), nil
 		case "Set":
 			v, err = pb.Results[i].Changed, nil
-		case "Clear":
+		case "Clear", "ClearValue", "SetValue", "Store":
 			v, err = pb.Results[i].Changed, nil
 		case "SetRowAttrs":
 		case "SetColumnAttrs":
@@ -1479,6 +3244,10 @@ func (e *executor) mapReduce(ctx context.Context, index string, shards []uint64,
 		nodes = []*Node{e.Cluster.unprotectedNodeByID(e.Node.ID)}
 	}
 
+	// Note the shards left to map, if the query is in the registry.
+	aq := activeQueryFrom(ctx)
+	aq.startShards(len(shards))
+
 	// Start mapping across all primary owners.
 	if err := e.mapper(ctx, ch, nodes, index, shards, c, opt, mapFn, reduceFn); err != nil {
 		return nil, errors.Wrap(err, "starting mapper")
@@ -1496,6 +3265,11 @@ func (e *executor) mapReduce(ctx context.Context, index string, shards []uint64,
 			// the context will cancel and cause all open goroutines to return.
 
 			if resp.err != nil {
+				// Don't retry once the query is cancelled or times out.
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
 				// Filter out unavailable nodes.
 				nodes = Nodes(nodes).Filter(resp.node)
 
@@ -1510,6 +3284,7 @@ func (e *executor) mapReduce(ctx context.Context, index string, shards []uint64,
 
 			// Reduce value.
 			result = reduceFn(result, resp.result)
+			aq.doneShards(len(resp.shards))
 
 			// If all shards have been processed then return.
 			maxShard += len(resp.shards)
@@ -1534,7 +3309,9 @@ func (e *executor) mapper(ctx context.Context, ch chan mapResponse, nodes []*Nod
 
 			// Send local shards to mapper, otherwise remote exec.
 			if n.ID == e.Node.ID {
+				start := time.Now()
 				resp.result, resp.err = e.mapperLocal(ctx, nodeShards, mapFn, reduceFn)
+				callProfilerFrom(ctx).addNodeTime(n.ID, time.Since(start))
 			} else if !opt.Remote {
 				results, err := e.remoteExec(ctx, n, index, &pql.Query{Calls: []*pql.Call{c}}, nodeShards, opt)
 				if len(results) > 0 {
@@ -1557,10 +3334,13 @@ func (e *executor) mapper(ctx context.Context, ch chan mapResponse, nodes []*Nod
 // mapperLocal performs map & reduce entirely on the local node.
 func (e *executor) mapperLocal(ctx context.Context, shards []uint64, mapFn mapFunc, reduceFn reduceFunc) (interface{}, error) {
 	ch := make(chan mapResponse, len(shards))
+	p := callProfilerFrom(ctx)
 
 	for _, shard := range shards {
 		go func(shard uint64) {
+			start := time.Now()
 			result, err := mapFn(shard)
+			p.addShardTime(shard, time.Since(start))
 
 			// Return response to the channel.
 			select {
@@ -1594,11 +3374,17 @@ func (e *executor) mapperLocal(ctx context.Context, shards []uint64, mapFn mapFu
 
 func (e *executor) translateCall(index string, idx *Index, c *pql.Call) error {
 	var colKey, rowKey, fieldName string
-	if c.Name == "Set" || c.Name == "Clear" || c.Name == "Row" {
+	if c.Name == "Set" || c.Name == "Clear" || c.Name == "Row" || c.Name == "Store" {
 		// Positional args in new PQL syntax require special handling here.
 		colKey = "_" + columnLabel
 		fieldName, _ = c.FieldArg()
 		rowKey = fieldName
+	} else if c.Name == "Rows" {
+		colKey = "column"
+		if fieldName = callArgString(c, "_field"); fieldName == "" {
+			fieldName = callArgString(c, "field")
+		}
+		rowKey = "previous"
 	} else {
 		colKey = "col"
 		fieldName = callArgString(c, "field")
@@ -1628,7 +3414,16 @@ func (e *executor) translateCall(index string, idx *Index, c *pql.Call) error {
 		if field == nil {
 			return ErrFieldNotFound
 		}
-		if field.Keys() {
+		if field.Type() == FieldTypeBool {
+			// Bool fields are queried with true/false in place of row IDs.
+			if value, ok := c.Args[rowKey]; ok {
+				b, ok := value.(bool)
+				if !ok {
+					return ErrInvalidBoolValue
+				}
+				c.Args[rowKey] = boolRowID(b)
+			}
+		} else if field.Keys() {
 			if c.Args[rowKey] != nil && !isString(c.Args[rowKey]) {
 				return errors.New("row value must be a string when field 'keys' option enabled")
 			}
@@ -1646,6 +3441,11 @@ func (e *executor) translateCall(index string, idx *Index, c *pql.Call) error {
 		}
 	}
 
+	// Translate the row of a write precondition.
+	if err := e.translateWriteCondition(index, idx, c); err != nil {
+		return err
+	}
+
 	// Translate child calls.
 	for _, child := range c.Children {
 		if err := e.translateCall(index, idx, child); err != nil {
@@ -1653,6 +3453,70 @@ func (e *executor) translateCall(index string, idx *Index, c *pql.Call) error {
 		}
 	}
 
+	// Translate calls passed as arguments, such as a GroupBy() filter.
+	for _, v := range c.Args {
+		if call, ok := v.(*pql.Call); ok {
+			if err := e.translateCall(index, idx, call); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// translateRowToString returns the label used in results for a row. This is
+// the row key for fields with keys and "true" or "false" for bool fields.
+func (e *executor) translateRowToString(index string, field *Field, rowID uint64) (string, error) {
+	if field.Type() == FieldTypeBool {
+		return strconv.FormatBool(rowID == trueRowID), nil
+	}
+	return e.TranslateStore.TranslateRowToString(index, field.Name(), rowID)
+}
+
+// boolRowID returns the row ID used to store a bool value.
+func boolRowID(v bool) uint64 {
+	if v {
+		return trueRowID
+	}
+	return falseRowID
+}
+
+// translateWriteCondition translates the row of an ifSet or ifNotSet
+// precondition to an id if the condition's field uses keys or is a bool field.
+func (e *executor) translateWriteCondition(index string, idx *Index, c *pql.Call) error {
+	for _, arg := range []string{"ifSet", "ifNotSet"} {
+		s, _ := c.Args[arg].(string)
+		i := strings.LastIndex(s, ":")
+		if i <= 0 {
+			// Malformed conditions are reported on execution.
+			continue
+		}
+		fieldName, row := s[:i], s[i+1:]
+
+		field := idx.Field(fieldName)
+		if field == nil {
+			return ErrFieldNotFound
+		}
+
+		var rowID uint64
+		switch {
+		case field.Type() == FieldTypeBool:
+			if row != "true" && row != "false" {
+				return ErrInvalidBoolValue
+			}
+			rowID = boolRowID(row == "true")
+		case field.Keys():
+			ids, err := e.TranslateStore.TranslateRowsToUint64(index, fieldName, []string{row})
+			if err != nil {
+				return err
+			}
+			rowID = ids[0]
+		default:
+			continue
+		}
+		c.Args[arg] = fieldName + ":" + strconv.FormatUint(rowID, 10)
+	}
 	return nil
 }
 
@@ -1679,10 +3543,10 @@ func (e *executor) translateResult(index string, idx *Index, call *pql.Call, res
 			if field == nil {
 				return nil, ErrFieldNotFound
 			}
-			if field.Keys() {
+			if field.Keys() || field.Type() == FieldTypeBool {
 				other := make([]Pair, len(result))
 				for i := range result {
-					key, err := e.TranslateStore.TranslateRowToString(index, fieldName, result[i].ID)
+					key, err := e.translateRowToString(index, field, result[i].ID)
 					if err != nil {
 						return nil, err
 					}
@@ -1691,6 +3555,77 @@ func (e *executor) translateResult(index string, idx *Index, call *pql.Call, res
 				return other, nil
 			}
 		}
+
+	case RowIdentifiers:
+		fieldName := callArgString(call, "_field")
+		if fieldName == "" {
+			fieldName = callArgString(call, "field")
+		}
+		if fieldName != "" {
+			field := idx.Field(fieldName)
+			if field == nil {
+				return nil, ErrFieldNotFound
+			}
+			if field.Keys() || field.Type() == FieldTypeBool {
+				other := RowIdentifiers{Rows: result.Rows, Keys: make([]string, len(result.Rows))}
+				for i, id := range result.Rows {
+					key, err := e.translateRowToString(index, field, id)
+					if err != nil {
+						return nil, err
+					}
+					other.Keys[i] = key
+				}
+				return other, nil
+			}
+		}
+
+	case []ColumnValue:
+		if idx.Keys() {
+			other := make([]ColumnValue, len(result))
+			for i := range result {
+				key, err := e.TranslateStore.TranslateColumnToString(index, result[i].ID)
+				if err != nil {
+					return nil, err
+				}
+				other[i] = ColumnValue{Key: key, Value: result[i].Value, FloatValue: result[i].FloatValue}
+			}
+			return other, nil
+		}
+
+	case ExtractedTable:
+		if idx.Keys() {
+			other := ExtractedTable{Fields: result.Fields, Columns: make([]ExtractedColumn, len(result.Columns))}
+			for i, col := range result.Columns {
+				key, err := e.TranslateStore.TranslateColumnToString(index, col.ID)
+				if err != nil {
+					return nil, err
+				}
+				other.Columns[i] = ExtractedColumn{Key: key, Values: col.Values, FloatValues: col.FloatValues}
+			}
+			return other, nil
+		}
+
+	case []GroupCount:
+		other := make([]GroupCount, len(result))
+		for i, gc := range result {
+			group := make([]FieldRow, len(gc.Group))
+			for j, fr := range gc.Group {
+				field := idx.Field(fr.Field)
+				if field == nil {
+					return nil, ErrFieldNotFound
+				}
+				if field.Keys() || field.Type() == FieldTypeBool {
+					key, err := e.translateRowToString(index, field, fr.RowID)
+					if err != nil {
+						return nil, err
+					}
+					fr.RowKey = key
+				}
+				group[j] = fr
+			}
+			other[i] = GroupCount{Group: group, Count: gc.Count}
+		}
+		return other, nil
 	}
 	return result, nil
 }
@@ -1759,9 +3694,12 @@ func needsShards(calls []*pql.Call) bool {
 }
 
 // ValCount represents a grouping of sum & count for Sum() and Average() calls.
+// FloatVal holds the exact value for results which may be fractional, such
+// as an average.
 type ValCount struct {
-	Val   int64 `json:"value"`
-	Count int64 `json:"count"`
+	Val      int64   `json:"value"`
+	FloatVal float64 `json:"floatValue,omitempty"`
+	Count    int64   `json:"count"`
 }
 
 func (vc *ValCount) Add(other ValCount) ValCount {
@@ -1771,17 +3709,48 @@ func (vc *ValCount) Add(other ValCount) ValCount {
 	}
 }
 
+// average converts a sum & count into an average. Val is set to the average
+// truncated to an integer.
+func (vc *ValCount) average() ValCount {
+	if vc.Count == 0 {
+		return ValCount{}
+	}
+	return ValCount{
+		Val:      vc.Val / vc.Count,
+		FloatVal: float64(vc.Val) / float64(vc.Count),
+		Count:    vc.Count,
+	}
+}
+
+// unscaled converts a value computed from the stored integers of a bsiGroup
+// into the units of its field. FloatVal holds the exact value and Val is
+// truncated to an integer.
+func (vc *ValCount) unscaled(bsig *bsiGroup) ValCount {
+	if bsig == nil || bsig.Scale == 0 {
+		return *vc
+	}
+
+	v := float64(vc.Val)
+	if vc.FloatVal != 0 {
+		v = vc.FloatVal
+	}
+	v /= math.Pow10(int(bsig.Scale))
+	return ValCount{Val: int64(v), FloatVal: v, Count: vc.Count}
+}
+
 func EncodeValCount(vc ValCount) *internal.ValCount {
 	return &internal.ValCount{
-		Val:   vc.Val,
-		Count: vc.Count,
+		Val:      vc.Val,
+		FloatVal: vc.FloatVal,
+		Count:    vc.Count,
 	}
 }
 
 func decodeValCount(pb *internal.ValCount) ValCount {
 	return ValCount{
-		Val:   pb.Val,
-		Count: pb.Count,
+		Val:      pb.Val,
+		FloatVal: pb.FloatVal,
+		Count:    pb.Count,
 	}
 }
 
@@ -1807,6 +3776,365 @@ func (vc *ValCount) Larger(other ValCount) ValCount {
 	}
 }
 
+// RowIdentifiers is a return type for a list of row IDs or row keys.
+type RowIdentifiers struct {
+	Rows []uint64 `json:"rows"`
+	Keys []string `json:"keys,omitempty"`
+}
+
+// merge combines two sorted lists of row IDs, discarding duplicates. If
+// limit is non-zero then the result is truncated to limit rows.
+func (r RowIdentifiers) merge(other RowIdentifiers, limit int) RowIdentifiers {
+	a, b := r.Rows, other.Rows
+	rows := make([]uint64, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+
+		switch {
+		case len(b) == 0 || (len(a) > 0 && a[0] < b[0]):
+			rows, a = append(rows, a[0]), a[1:]
+		case len(a) == 0 || b[0] < a[0]:
+			rows, b = append(rows, b[0]), b[1:]
+		default:
+			rows, a, b = append(rows, a[0]), a[1:], b[1:]
+		}
+	}
+	return RowIdentifiers{Rows: rows}
+}
+
+// EncodeRowIdentifiers converts r into its internal representation.
+func EncodeRowIdentifiers(r RowIdentifiers) *internal.RowIdentifiers {
+	return &internal.RowIdentifiers{
+		Rows: r.Rows,
+		Keys: r.Keys,
+	}
+}
+
+func decodeRowIdentifiers(pb *internal.RowIdentifiers) RowIdentifiers {
+	if pb == nil {
+		return RowIdentifiers{}
+	}
+	return RowIdentifiers{
+		Rows: pb.Rows,
+		Keys: pb.Keys,
+	}
+}
+
+// ColumnValue represents a column and its value in an int field.
+type ColumnValue struct {
+	ID         uint64  `json:"id"`
+	Key        string  `json:"key,omitempty"`
+	Value      int64   `json:"value"`
+	FloatValue float64 `json:"floatValue,omitempty"`
+}
+
+// unscaled converts a value read from a bsiGroup into the units of its field.
+// FloatValue holds the exact value and Value is truncated to an integer.
+func (cv ColumnValue) unscaled(bsig *bsiGroup) ColumnValue {
+	if bsig == nil {
+		return cv
+	}
+	if v, ok := bsig.unscaledValue(cv.Value).(float64); ok {
+		cv.Value, cv.FloatValue = int64(v), v
+	}
+	return cv
+}
+
+// mergeColumnValues combines two lists of column values which are sorted by
+// value, descending unless bottom is set, and then by column ID. If limit is
+// non-zero then the result is truncated to limit columns.
+func mergeColumnValues(a, b []ColumnValue, limit int, bottom bool) []ColumnValue {
+	less := func(x, y ColumnValue) bool {
+		if x.Value != y.Value {
+			if bottom {
+				return x.Value < y.Value
+			}
+			return x.Value > y.Value
+		}
+		return x.ID < y.ID
+	}
+
+	results := make([]ColumnValue, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+
+		if len(b) == 0 || (len(a) > 0 && less(a[0], b[0])) {
+			results, a = append(results, a[0]), a[1:]
+		} else {
+			results, b = append(results, b[0]), b[1:]
+		}
+	}
+	return results
+}
+
+// EncodeColumnValues converts a into its internal representation.
+func EncodeColumnValues(a []ColumnValue) []*internal.ColumnValue {
+	other := make([]*internal.ColumnValue, len(a))
+	for i := range a {
+		other[i] = &internal.ColumnValue{
+			ID:         a[i].ID,
+			Key:        a[i].Key,
+			Value:      a[i].Value,
+			FloatValue: a[i].FloatValue,
+		}
+	}
+	return other
+}
+
+func decodeColumnValues(a []*internal.ColumnValue) []ColumnValue {
+	other := make([]ColumnValue, len(a))
+	for i := range a {
+		other[i] = ColumnValue{
+			ID:         a[i].ID,
+			Key:        a[i].Key,
+			Value:      a[i].Value,
+			FloatValue: a[i].FloatValue,
+		}
+	}
+	return other
+}
+
+// ExtractedTable is the result of an Extract() call. It holds a row of values
+// for each column, ordered by column ID.
+type ExtractedTable struct {
+	Fields  []string          `json:"fields"`
+	Columns []ExtractedColumn `json:"columns"`
+}
+
+// ExtractedColumn represents a column in an ExtractedTable. Values are in the
+// same order as the table's fields and are nil if the column has no value.
+// Values of fields with a scale are truncated to an integer and their exact
+// values are held at the same position in FloatValues.
+type ExtractedColumn struct {
+	ID          uint64     `json:"id"`
+	Key         string     `json:"key,omitempty"`
+	Values      []*int64   `json:"values"`
+	FloatValues []*float64 `json:"floatValues,omitempty"`
+}
+
+// merge combines the columns of two tables which are sorted by column ID.
+func (t ExtractedTable) merge(other ExtractedTable) ExtractedTable {
+	fields := t.Fields
+	if fields == nil {
+		fields = other.Fields
+	}
+
+	a, b := t.Columns, other.Columns
+	columns := make([]ExtractedColumn, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		if len(b) == 0 || (len(a) > 0 && a[0].ID < b[0].ID) {
+			columns, a = append(columns, a[0]), a[1:]
+		} else {
+			columns, b = append(columns, b[0]), b[1:]
+		}
+	}
+	return ExtractedTable{Fields: fields, Columns: columns}
+}
+
+// unscaled converts the values read from the bsiGroup of each field into the
+// units of the field.
+func (t ExtractedTable) unscaled(bsigs []*bsiGroup) ExtractedTable {
+	for j, bsig := range bsigs {
+		if bsig == nil || bsig.Scale == 0 {
+			continue
+		}
+		for i := range t.Columns {
+			col := &t.Columns[i]
+			if col.Values[j] == nil {
+				continue
+			}
+			f, _ := bsig.unscaledValue(*col.Values[j]).(float64)
+			v := int64(f)
+			if col.FloatValues == nil {
+				col.FloatValues = make([]*float64, len(col.Values))
+			}
+			col.Values[j], col.FloatValues[j] = &v, &f
+		}
+	}
+	return t
+}
+
+// EncodeExtractedTable converts t into its internal representation.
+func EncodeExtractedTable(t ExtractedTable) *internal.ExtractedTable {
+	pb := &internal.ExtractedTable{
+		Fields:  t.Fields,
+		Columns: make([]*internal.ExtractedColumn, len(t.Columns)),
+	}
+	for i, col := range t.Columns {
+		values := make([]*internal.ExtractedValue, len(col.Values))
+		for j, v := range col.Values {
+			values[j] = &internal.ExtractedValue{}
+			if v != nil {
+				values[j].Value, values[j].Exists = *v, true
+			}
+			if j < len(col.FloatValues) && col.FloatValues[j] != nil {
+				values[j].FloatValue = *col.FloatValues[j]
+			}
+		}
+		pb.Columns[i] = &internal.ExtractedColumn{ID: col.ID, Key: col.Key, Values: values}
+	}
+	return pb
+}
+
+func decodeExtractedTable(pb *internal.ExtractedTable) ExtractedTable {
+	if pb == nil {
+		return ExtractedTable{}
+	}
+
+	t := ExtractedTable{
+		Fields:  pb.Fields,
+		Columns: make([]ExtractedColumn, len(pb.Columns)),
+	}
+	for i, col := range pb.Columns {
+		values := make([]*int64, len(col.Values))
+		var floatValues []*float64
+		for j, v := range col.Values {
+			if v.Exists {
+				value := v.Value
+				values[j] = &value
+			}
+			if v.FloatValue != 0 {
+				if floatValues == nil {
+					floatValues = make([]*float64, len(col.Values))
+				}
+				value := v.FloatValue
+				floatValues[j] = &value
+			}
+		}
+		t.Columns[i] = ExtractedColumn{ID: col.ID, Key: col.Key, Values: values, FloatValues: floatValues}
+	}
+	return t
+}
+
+// GroupCount represents a result item for a group by query.
+type GroupCount struct {
+	Group []FieldRow `json:"group"`
+	Count uint64     `json:"count"`
+}
+
+// FieldRow is used to distinguish rows in a group by result.
+type FieldRow struct {
+	Field  string `json:"field"`
+	RowID  uint64 `json:"rowID"`
+	RowKey string `json:"rowKey,omitempty"`
+}
+
+// compare returns -1 if g sorts before other, 1 if it sorts after, and 0 if
+// both describe the same group. Groups must be made up of the same fields.
+func (g GroupCount) compare(other GroupCount) int {
+	for i := range g.Group {
+		if g.Group[i].RowID < other.Group[i].RowID {
+			return -1
+		} else if g.Group[i].RowID > other.Group[i].RowID {
+			return 1
+		}
+	}
+	return 0
+}
+
+// mergeGroupCounts combines two sorted lists of group counts, summing the
+// counts of matching groups. If limit is non-zero then the result is
+// truncated to limit groups.
+func mergeGroupCounts(a, b []GroupCount, limit int) []GroupCount {
+	results := make([]GroupCount, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+
+		switch {
+		case len(b) == 0:
+			results, a = append(results, a[0]), a[1:]
+		case len(a) == 0:
+			results, b = append(results, b[0]), b[1:]
+		default:
+			switch a[0].compare(b[0]) {
+			case -1:
+				results, a = append(results, a[0]), a[1:]
+			case 1:
+				results, b = append(results, b[0]), b[1:]
+			default:
+				results = append(results, GroupCount{Group: a[0].Group, Count: a[0].Count + b[0].Count})
+				a, b = a[1:], b[1:]
+			}
+		}
+	}
+	return results
+}
+
+// EncodeGroupCounts converts a into its internal representation.
+func EncodeGroupCounts(a []GroupCount) []*internal.GroupCount {
+	other := make([]*internal.GroupCount, len(a))
+	for i := range a {
+		group := make([]*internal.FieldRow, len(a[i].Group))
+		for j, fr := range a[i].Group {
+			group[j] = &internal.FieldRow{
+				Field:  fr.Field,
+				RowID:  fr.RowID,
+				RowKey: fr.RowKey,
+			}
+		}
+		other[i] = &internal.GroupCount{
+			Group: group,
+			Count: a[i].Count,
+		}
+	}
+	return other
+}
+
+func decodeGroupCounts(a []*internal.GroupCount) []GroupCount {
+	other := make([]GroupCount, len(a))
+	for i := range a {
+		group := make([]FieldRow, len(a[i].Group))
+		for j, fr := range a[i].Group {
+			group[j] = FieldRow{
+				Field:  fr.Field,
+				RowID:  fr.RowID,
+				RowKey: fr.RowKey,
+			}
+		}
+		other[i] = GroupCount{
+			Group: group,
+			Count: a[i].Count,
+		}
+	}
+	return other
+}
+
+// TimeCount represents a result item for a CountByTime query. Time is the
+// start of the bucket.
+type TimeCount struct {
+	Time  time.Time `json:"time"`
+	Count uint64    `json:"count"`
+}
+
+// EncodeTimeCounts converts a into its internal representation.
+func EncodeTimeCounts(a []TimeCount) []*internal.TimeCount {
+	other := make([]*internal.TimeCount, len(a))
+	for i := range a {
+		other[i] = &internal.TimeCount{
+			Time:  a[i].Time.UnixNano(),
+			Count: a[i].Count,
+		}
+	}
+	return other
+}
+
+func decodeTimeCounts(a []*internal.TimeCount) []TimeCount {
+	other := make([]TimeCount, len(a))
+	for i := range a {
+		other[i] = TimeCount{
+			Time:  time.Unix(0, a[i].Time).UTC(),
+			Count: a[i].Count,
+		}
+	}
+	return other
+}
+
 func callArgString(call *pql.Call, key string) string {
 	value, ok := call.Args[key]
 	if !ok {
@@ -17,10 +17,13 @@ package pilosa_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/go-cmp/cmp"
@@ -28,6 +31,7 @@ import (
 	"github.com/pilosa/pilosa"
 	"github.com/pilosa/pilosa/server"
 	"github.com/pilosa/pilosa/test"
+	"github.com/pilosa/pilosa/toml"
 	"github.com/pkg/errors"
 )
 
@@ -253,6 +257,59 @@ func TestExecutor_Execute_Xor(t *testing.T) {
 	}
 }
 
+// Ensure a not query can be executed.
+func TestExecutor_Execute_Not(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{TrackExistence: true})
+		if _, err := index.CreateFieldIfNotExists("f", pilosa.FieldOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := index.CreateFieldIfNotExists("v", pilosa.FieldOptions{
+			Type: pilosa.FieldTypeInt,
+			Min:  0,
+			Max:  100,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `` +
+			fmt.Sprintf("Set(%d, f=%d)\n", 3, 10) +
+			fmt.Sprintf("Set(%d, f=%d)\n", ShardWidth+1, 10) +
+			fmt.Sprintf("Set(%d, f=%d)\n", ShardWidth+2, 20) +
+			fmt.Sprintf("SetValue(col=%d, v=%d)\n", 5, 40),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Not(Row(f=10))`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{5, ShardWidth + 2}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Not(Union(Row(f=10), Row(f=20))))`}); err != nil {
+			t.Fatal(err)
+		} else if res.Results[0] != uint64(1) {
+			t.Fatalf("unexpected n: %d", res.Results[0])
+		}
+	})
+
+	t.Run("ErrExistenceNotTracked", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		hldr.SetBit("i", "f", 10, 3)
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Not(Row(f=10))`}); errors.Cause(err) != pilosa.ErrIndexExistenceNotTracked {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
 // Ensure a count query can be executed.
 func TestExecutor_Execute_Count(t *testing.T) {
 	c := test.MustRunCluster(t, 1)
@@ -372,6 +429,101 @@ func TestExecutor_Execute_SetBit(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Mutex", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "plan", pilosa.OptFieldTypeMutex(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		}
+
+		col := strconv.Itoa((2 * ShardWidth) + 1)
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(1, plan=1)
+			Set(` + col + `, plan=1)
+			Set(1, plan=2)
+			Set(` + col + `, plan=3)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := c[1].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Row(plan=1) Row(plan=2) Row(plan=3)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); len(columns) != 0 {
+			t.Fatalf("unexpected columns: %+v", columns)
+		} else if columns := res.Results[1].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{1}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		} else if columns := res.Results[2].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{(2 * ShardWidth) + 1}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		}
+
+		// Setting the current row reports no change.
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Set(1, plan=2)`}); err != nil {
+			t.Fatal(err)
+		} else if res.Results[0].(bool) {
+			t.Fatal("expected column unchanged")
+		}
+	})
+}
+
+// Ensure bool fields can be set & queried with true/false values.
+func TestExecutor_Execute_Bool(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "flag", pilosa.OptFieldTypeBool()); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+		t.Fatal(err)
+	}
+
+	col := strconv.Itoa(ShardWidth + 1)
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(1, flag=true)
+		Set(2, flag=true)
+		Set(` + col + `, flag=false)
+		Set(2, flag=false)
+		Set(1, x=10)
+		Set(2, x=10)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Row", func(t *testing.T) {
+		if res, err := c[1].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Row(flag=true) Row(flag=false)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{1}) {
+			t.Fatalf("unexpected true columns: %+v", columns)
+		} else if columns := res.Results[1].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2, ShardWidth + 1}) {
+			t.Fatalf("unexpected false columns: %+v", columns)
+		}
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(flag) GroupBy(Rows(flag), filter=Row(x=10))`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(res.Results[0], pilosa.RowIdentifiers{Rows: []uint64{0, 1}, Keys: []string{"false", "true"}}); diff != "" {
+			t.Fatal(diff)
+		} else if diff := cmp.Diff(res.Results[1], []pilosa.GroupCount{
+			{Group: []pilosa.FieldRow{{Field: "flag", RowID: 0, RowKey: "false"}}, Count: 1},
+			{Group: []pilosa.FieldRow{{Field: "flag", RowID: 1, RowKey: "true"}}, Count: 1},
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, query := range []string{`Set(1, flag=2)`, `Set(1, flag="true")`, `Row(flag=1)`} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); errors.Cause(err) != pilosa.ErrInvalidBoolValue {
+				t.Fatalf("expected invalid bool error for %s, got: %v", query, err)
+			}
+		}
+	})
 }
 
 // Ensure old PQL syntax doesn't break anything too badly.
@@ -466,6 +618,394 @@ func TestExecutor_Execute_SetValue(t *testing.T) {
 	})
 }
 
+// Ensure a ClearValue() query can be executed.
+func TestExecutor_Execute_ClearValue(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeInt(-50, 50)); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			SetValue(col=1, f=-30)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, f=20)
+			SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, f=40)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Clear values on different shards; the last column has no value.
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			ClearValue(col=1, field=f)
+			ClearValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, field=f)
+			ClearValue(col=` + strconv.Itoa((3*ShardWidth)+3) + `, field=f)
+		`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results, []interface{}{true, true, false}) {
+			t.Fatalf("unexpected results: %#v", result.Results)
+		}
+
+		// Ensure cleared columns no longer contribute to aggregates.
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=f)`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 20, Count: 1}) {
+			t.Fatalf("unexpected sum: %#v", result.Results[0])
+		} else if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Min(field=f)`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 20, Count: 1}) {
+			t.Fatalf("unexpected min: %#v", result.Results[0])
+		} else if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f != null)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := result.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{ShardWidth + 1}) {
+			t.Fatalf("unexpected columns: %v", columns)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `ClearValue(field=x)`}); err == nil || err.Error() != `executing: ClearValue() column field 'col' required` {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `ClearValue(col=1)`}); err == nil || err.Error() != `executing: ClearValue(): field required` {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `ClearValue(col=1, field=x)`}); errors.Cause(err) != pilosa.ErrBSIGroupNotFound {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure an Increment() query can be executed.
+func TestExecutor_Execute_Increment(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeInt(-10, 100)); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, 0, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	col := strconv.Itoa((2 * ShardWidth) + 1)
+	for _, tt := range []struct {
+		query string
+		exp   pilosa.ValCount
+	}{
+		{query: `Increment(col=` + col + `, field=f)`, exp: pilosa.ValCount{Val: 1, Count: 1}},
+		{query: `Increment(col=` + col + `, field=f, by=10)`, exp: pilosa.ValCount{Val: 11, Count: 1}},
+		{query: `Increment(col=` + col + `, field=f, by=-15)`, exp: pilosa.ValCount{Val: -4, Count: 1}},
+		{query: `Increment(col=` + col + `, field=price, by=2.25)`, exp: pilosa.ValCount{Val: 2, FloatVal: 2.25, Count: 1}},
+		{query: `Increment(col=` + col + `, field=price, by=0.75)`, exp: pilosa.ValCount{Val: 3, FloatVal: 3, Count: 1}},
+	} {
+		if result, err := c[1].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		} else if !reflect.DeepEqual(result.Results[0], tt.exp) {
+			t.Fatalf("%s: unexpected result: %#v", tt.query, result.Results[0])
+		}
+	}
+
+	// Ensure the value can be read back from any node.
+	if result, err := c[2].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=f)`}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: -4, Count: 1}) {
+		t.Fatalf("unexpected sum: %#v", result.Results[0])
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   error
+		}{
+			{query: `Increment(col=` + col + `, field=f, by=-7)`, err: pilosa.ErrBSIGroupValueTooLow},
+			{query: `Increment(col=` + col + `, field=f, by=105)`, err: pilosa.ErrBSIGroupValueTooHigh},
+			{query: `Increment(col=` + col + `, field=f, by=1.5)`, err: pilosa.ErrInvalidBSIGroupValueScale},
+			{query: `Increment(col=` + col + `, field=f, by="a")`, err: pilosa.ErrInvalidBSIGroupValueType},
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err == nil || !strings.Contains(err.Error(), tt.err.Error()) {
+				t.Fatalf("%s: unexpected error: %v", tt.query, err)
+			}
+		}
+
+		// Ensure failed increments leave the value unchanged.
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=f)`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: -4, Count: 1}) {
+			t.Fatalf("unexpected sum: %#v", result.Results[0])
+		}
+	})
+}
+
+// Ensure an increment is computed by the primary and copied to replicas.
+func TestExecutor_Execute_Increment_Replicas(t *testing.T) {
+	replicas := func(m *server.Command) error {
+		m.Config.Cluster.ReplicaN = 3
+		return nil
+	}
+	c := test.MustRunCluster(t, 3, []server.CommandOption{replicas})
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, 0, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	const col = (2 * ShardWidth) + 1
+	nodes, err := c[0].API.ShardNodes(context.Background(), "i", col/ShardWidth)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(nodes) != 3 {
+		t.Fatalf("unexpected shard nodes: %d", len(nodes))
+	}
+	var primary, replica *test.Command
+	for _, m := range c {
+		if m.Server.Node().ID == nodes[0].ID {
+			primary = m
+		} else if m.Server.Node().ID == nodes[1].ID {
+			replica = m
+		}
+	}
+
+	// Give the primary and a replica different values, bypassing replication.
+	if _, err := primary.Server.Holder().Field("i", "price").SetValue(col, 150); err != nil {
+		t.Fatal(err)
+	} else if _, err := replica.Server.Holder().Field("i", "price").SetValue(col, 900); err != nil {
+		t.Fatal(err)
+	}
+
+	if result, err := replica.API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: fmt.Sprintf(`Increment(col=%d, field=price, by=0.25)`, col)}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 1, FloatVal: 1.75, Count: 1}) {
+		t.Fatalf("unexpected result: %#v", result.Results[0])
+	}
+
+	// Every node holds the value computed by the primary.
+	for _, m := range c {
+		if v, exists, err := m.Server.Holder().Field("i", "price").Value(col); err != nil {
+			t.Fatal(err)
+		} else if !exists || v != 175 {
+			t.Fatalf("unexpected value on %s: %d, %v", m.Server.Node().ID, v, exists)
+		}
+	}
+}
+
+// Ensure Set() and SetValue() calls can be guarded by preconditions.
+func TestExecutor_Execute_ConditionalWrites(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for name, opt := range map[string]pilosa.FieldOption{
+		"f": pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize),
+		"g": pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize),
+		"b": pilosa.OptFieldTypeBool(),
+		"v": pilosa.OptFieldTypeInt(0, 100),
+		"w": pilosa.OptFieldTypeInt(0, 100),
+	} {
+		if _, err := c[0].API.CreateField(context.Background(), "i", name, opt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	col := strconv.Itoa((2 * ShardWidth) + 1)
+	for _, tt := range []struct {
+		query string
+		exp   bool
+	}{
+		{query: `SetValue(col=` + col + `, v=10, ifValue=null)`, exp: true},
+		{query: `SetValue(col=` + col + `, v=20, ifValue=null)`, exp: false},
+		{query: `SetValue(col=` + col + `, v=20, ifValue=7)`, exp: false},
+		{query: `SetValue(col=` + col + `, v=20, ifValue=10)`, exp: true},
+		{query: `SetValue(col=` + col + `, v=20, ifValue=200)`, exp: false},
+		{query: `Set(` + col + `, f=1, ifNotSet=g:2)`, exp: true},
+		{query: `Set(` + col + `, g=2)`, exp: true},
+		{query: `Set(` + col + `, f=3, ifNotSet=g:2)`, exp: false},
+		{query: `Set(` + col + `, f=4, ifSet=g:2)`, exp: true},
+		{query: `Set(` + col + `, f=4, ifSet=g:2)`, exp: true},
+		{query: `Set(` + col + `, f=5, ifSet=b:true)`, exp: false},
+		{query: `Set(` + col + `, b=true)`, exp: true},
+		{query: `Set(` + col + `, f=5, ifSet="b:true")`, exp: true},
+	} {
+		if result, err := c[1].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		} else if result.Results[0] != tt.exp {
+			t.Fatalf("%s: unexpected result: %v", tt.query, result.Results[0])
+		}
+	}
+
+	// Ensure only the applied writes are visible.
+	if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=v)`}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 20, Count: 1}) {
+		t.Fatalf("unexpected sum: %#v", result.Results[0])
+	}
+	if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(f)`}); err != nil {
+		t.Fatal(err)
+	} else if rows := result.Results[0].(pilosa.RowIdentifiers).Rows; !reflect.DeepEqual(rows, []uint64{1, 4, 5}) {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   string
+		}{
+			{query: `Set(1, f=1, ifNotSet=g)`, err: `ifNotSet must be of the form "field:row"`},
+			{query: `Set(1, f=1, ifNotSet=g:x)`, err: `ifNotSet row must be an unsigned integer: x`},
+			{query: `Set(1, f=1, ifSet=g:1, ifNotSet=g:2)`, err: `only one of ifSet and ifNotSet may be given`},
+			{query: `Set(1, f=1, ifSet=v:1)`, err: `condition field 'v' cannot be an int field`},
+			{query: `Set(1, f=1, ifSet=z:1)`, err: pilosa.ErrFieldNotFound.Error()},
+			{query: `Set(1, f=1, ifSet=b:1)`, err: pilosa.ErrInvalidBoolValue.Error()},
+			{query: `Set(1, f=1, ifValue=1)`, err: `ifValue only applies to SetValue()`},
+			{query: `SetValue(col=1, v=1, ifSet=g:1)`, err: `ifSet only applies to Set()`},
+			{query: `SetValue(col=1, v=1, w=2, ifValue=1)`, err: `ifValue requires exactly one field`},
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("%s: unexpected error: %v", tt.query, err)
+			}
+		}
+	})
+}
+
+// Ensure guarded writes are decided by the primary and applied to replicas.
+func TestExecutor_Execute_ConditionalWrites_Replicas(t *testing.T) {
+	replicas := func(m *server.Command) error {
+		m.Config.Cluster.ReplicaN = 3
+		return nil
+	}
+	c := test.MustRunCluster(t, 3, []server.CommandOption{replicas})
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for name, opt := range map[string]pilosa.FieldOption{
+		"f": pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize),
+		"g": pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize),
+	} {
+		if _, err := c[0].API.CreateField(context.Background(), "i", name, opt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const col = (2 * ShardWidth) + 1
+	nodes, err := c[0].API.ShardNodes(context.Background(), "i", col/ShardWidth)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(nodes) != 3 {
+		t.Fatalf("unexpected shard nodes: %d", len(nodes))
+	}
+	var primary, replica *test.Command
+	for _, m := range c {
+		if m.Server.Node().ID == nodes[0].ID {
+			primary = m
+		} else if m.Server.Node().ID == nodes[1].ID {
+			replica = m
+		}
+	}
+
+	// Set the condition row on the nodes directly, bypassing replication.
+	setLocal := func(m *test.Command, field string, rowID uint64) {
+		if _, err := m.Server.Holder().Field("i", field).SetBit(rowID, col, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	query := func(m *test.Command, q string) interface{} {
+		result, err := m.API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: q})
+		if err != nil {
+			t.Fatalf("%s: %s", q, err)
+		}
+		return result.Results[0]
+	}
+	bitOnAll := func(field string, rowID uint64) (set []bool) {
+		for _, m := range c {
+			v, err := m.Server.Holder().Field("i", field).Bit(rowID, col)
+			if err != nil {
+				t.Fatal(err)
+			}
+			set = append(set, v)
+		}
+		return set
+	}
+
+	// A condition which only holds on a replica is not applied anywhere.
+	setLocal(replica, "g", 1)
+	for _, m := range c {
+		if res := query(m, fmt.Sprintf(`Set(%d, f=1, ifSet=g:1)`, col)); res != false {
+			t.Fatalf("unexpected result: %v", res)
+		}
+	}
+	if set := bitOnAll("f", 1); !reflect.DeepEqual(set, []bool{false, false, false}) {
+		t.Fatalf("unexpected bits: %v", set)
+	}
+
+	// A condition which holds on the primary is applied to every replica.
+	setLocal(primary, "g", 2)
+	if res := query(replica, fmt.Sprintf(`Set(%d, f=2, ifSet=g:2)`, col)); res != true {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	if set := bitOnAll("f", 2); !reflect.DeepEqual(set, []bool{true, true, true}) {
+		t.Fatalf("unexpected bits: %v", set)
+	}
+}
+
+// Ensure concurrent guarded writes see each other's writes.
+func TestExecutor_Execute_ConditionalWrites_Concurrent(t *testing.T) {
+	c := test.MustRunCluster(t, 1)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "b"} {
+		if _, err := c[0].API.CreateField(context.Background(), "i", name, pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each column may have either a or b set, but not both.
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*n)
+	for col := 0; col < n; col++ {
+		for _, q := range []string{`Set(%d, a=1, ifNotSet=b:1)`, `Set(%d, b=1, ifNotSet=a:1)`} {
+			wg.Add(1)
+			go func(q string) {
+				defer wg.Done()
+				_, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: q})
+				errs <- err
+			}(fmt.Sprintf(q, col))
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Intersect(Row(a=1), Row(b=1)))`}); err != nil {
+		t.Fatal(err)
+	} else if n := res.Results[0].(uint64); n != 0 {
+		t.Fatalf("unexpected columns with both rows set: %d", n)
+	}
+}
+
 // Ensure a SetRowAttrs() query can be executed.
 func TestExecutor_Execute_SetRowAttrs(t *testing.T) {
 	c := test.MustRunCluster(t, 1)
@@ -729,15 +1269,231 @@ func TestExecutor_Execute_TopN_Attr_Src(t *testing.T) {
 	}
 }
 
-// Ensure Min()  and Max() queries can be executed.
-func TestExecutor_Execute_MinMax(t *testing.T) {
-	c := test.MustRunCluster(t, 1)
-	defer c.Close()
-	hldr := test.Holder{Holder: c[0].Server.Holder()}
+// Ensure a Rows() query can be executed.
+func TestExecutor_Execute_Rows(t *testing.T) {
+	t.Run("ID", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
 
-	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
-	if err != nil {
-		t.Fatal(err)
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		}
+
+		// Set rows across several shards.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `` +
+			fmt.Sprintf("Set(%d, f=%d)\n", 1, 10) +
+			fmt.Sprintf("Set(%d, f=%d)\n", 2, 20) +
+			fmt.Sprintf("Set(%d, f=%d)\n", ShardWidth+1, 10) +
+			fmt.Sprintf("Set(%d, f=%d)\n", ShardWidth+1, 30) +
+			fmt.Sprintf("Set(%d, f=%d)\n", (2*ShardWidth)+5, 5) +
+			fmt.Sprintf("Set(%d, f=%d)\n", (2*ShardWidth)+5, 40),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			query string
+			rows  []uint64
+		}{
+			{query: `Rows(field=f)`, rows: []uint64{5, 10, 20, 30, 40}},
+			{query: `Rows(field=f, limit=2)`, rows: []uint64{5, 10}},
+			{query: `Rows(field=f, previous=10)`, rows: []uint64{20, 30, 40}},
+			{query: `Rows(field=f, previous=10, limit=2)`, rows: []uint64{20, 30}},
+			{query: `Rows(field=f, previous=40)`, rows: []uint64{}},
+			{query: fmt.Sprintf(`Rows(field=f, previous=%d)`, uint64(math.MaxUint64)/ShardWidth), rows: []uint64{}},
+			{query: fmt.Sprintf(`Rows(field=f, column=%d)`, ShardWidth+1), rows: []uint64{10, 30}},
+			{query: fmt.Sprintf(`Rows(field=f, column=%d, previous=10)`, ShardWidth+1), rows: []uint64{30}},
+		} {
+			t.Run(tt.query, func(t *testing.T) {
+				if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+					t.Fatal(err)
+				} else if rows := res.Results[0].(pilosa.RowIdentifiers).Rows; !reflect.DeepEqual(rows, tt.rows) {
+					t.Fatalf("unexpected rows: %+v", rows)
+				}
+			})
+		}
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		if idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("f", pilosa.FieldOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set("a", f="foo")
+			Set("b", f="bar")
+			Set("b", f="baz")
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(field=f, column="b")`}); err != nil {
+			t.Fatal(err)
+		} else if keys := res.Results[0].(pilosa.RowIdentifiers).Keys; !reflect.DeepEqual(keys, []string{"bar", "baz"}) {
+			t.Fatalf("unexpected keys: %+v", keys)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(field=f, previous="foo")`}); err != nil {
+			t.Fatal(err)
+		} else if keys := res.Results[0].(pilosa.RowIdentifiers).Keys; !reflect.DeepEqual(keys, []string{"bar", "baz"}) {
+			t.Fatalf("unexpected keys: %+v", keys)
+		}
+	})
+
+	t.Run("ErrIntField", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+		if _, err := index.CreateFieldIfNotExists("v", pilosa.FieldOptions{Type: pilosa.FieldTypeInt, Max: 100}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(field=v)`}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+// Ensure a GroupBy() query can be executed.
+func TestExecutor_Execute_GroupBy(t *testing.T) {
+	t.Run("ID", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"country", "device", "active"} {
+			if _, err := c[0].API.CreateField(context.Background(), "i", name, pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// Set columns across several shards.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `` +
+			fmt.Sprintf("Set(%d, country=1) Set(%d, device=10) Set(%d, active=1)\n", 1, 1, 1) +
+			fmt.Sprintf("Set(%d, country=1) Set(%d, device=20)\n", 2, 2) +
+			fmt.Sprintf("Set(%d, country=2) Set(%d, device=10) Set(%d, active=1)\n", 3, 3, 3) +
+			fmt.Sprintf("Set(%d, country=1) Set(%d, device=10) Set(%d, active=1)\n", ShardWidth+1, ShardWidth+1, ShardWidth+1) +
+			fmt.Sprintf("Set(%d, country=2) Set(%d, device=20) Set(%d, active=1)\n", (2*ShardWidth)+1, (2*ShardWidth)+1, (2*ShardWidth)+1),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		group := func(country, device uint64, n uint64) pilosa.GroupCount {
+			return pilosa.GroupCount{
+				Group: []pilosa.FieldRow{{Field: "country", RowID: country}, {Field: "device", RowID: device}},
+				Count: n,
+			}
+		}
+
+		for _, tt := range []struct {
+			query string
+			exp   []pilosa.GroupCount
+		}{
+			{
+				query: `GroupBy(Rows(country), Rows(device))`,
+				exp:   []pilosa.GroupCount{group(1, 10, 2), group(1, 20, 1), group(2, 10, 1), group(2, 20, 1)},
+			},
+			{
+				query: `GroupBy(Rows(country), Rows(device), filter=Row(active=1))`,
+				exp:   []pilosa.GroupCount{group(1, 10, 2), group(2, 10, 1), group(2, 20, 1)},
+			},
+			{
+				query: `GroupBy(Rows(country), Rows(device), limit=3)`,
+				exp:   []pilosa.GroupCount{group(1, 10, 2), group(1, 20, 1), group(2, 10, 1)},
+			},
+			{
+				query: `GroupBy(Rows(country, previous=1), Rows(device, previous=10), limit=2)`,
+				exp:   []pilosa.GroupCount{group(1, 20, 1), group(2, 10, 1)},
+			},
+			{
+				query: `GroupBy(Rows(country, previous=2), Rows(device, previous=20))`,
+				exp:   []pilosa.GroupCount{},
+			},
+		} {
+			t.Run(tt.query, func(t *testing.T) {
+				if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+					t.Fatal(err)
+				} else if diff := cmp.Diff(res.Results[0], tt.exp); diff != "" {
+					t.Fatal(diff)
+				}
+			})
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `GroupBy(Rows(country), Rows(device), Rows(active))`}); err != nil {
+			t.Fatal(err)
+		} else if groups := res.Results[0].([]pilosa.GroupCount); len(groups) != 3 {
+			t.Fatalf("unexpected groups: %+v", groups)
+		}
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		if idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("country", pilosa.FieldOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("device", pilosa.FieldOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set("a", country="us") Set("a", device=1)
+			Set("b", country="us") Set("b", device=1)
+			Set("c", country="fr") Set("c", device=2)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `GroupBy(Rows(country), Rows(device), filter=Row(country="us"))`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(res.Results[0], []pilosa.GroupCount{{
+			Group: []pilosa.FieldRow{{Field: "country", RowID: 1, RowKey: "us"}, {Field: "device", RowID: 1}},
+			Count: 2,
+		}}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		hldr.SetBit("i", "f", 10, 3)
+
+		for _, query := range []string{
+			`GroupBy()`,
+			`GroupBy(Row(f=10))`,
+			`GroupBy(Rows(f, limit=1))`,
+			`GroupBy(Rows(f, previous=1), Rows(f))`,
+			`GroupBy(Rows(f), filter=1)`,
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); err == nil {
+				t.Fatalf("expected error for %s", query)
+			}
+		}
+	})
+}
+
+// Ensure Min()  and Max() queries can be executed.
+func TestExecutor_Execute_MinMax(t *testing.T) {
+	c := test.MustRunCluster(t, 1)
+	defer c.Close()
+	hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
 	}
 
 	if _, err := idx.CreateField("x", pilosa.FieldOptions{}); err != nil {
@@ -808,144 +1564,1001 @@ func TestExecutor_Execute_MinMax(t *testing.T) {
 			{filter: `Row(x=1)`, exp: -5, cnt: 1},
 			{filter: `Row(x=2)`, exp: 40, cnt: 1},
 		}
-		for i, tt := range tests {
-			var pql string
-			if tt.filter == "" {
-				pql = `Max(field=f)`
-			} else {
-				pql = fmt.Sprintf(`Max(%s, field=f)`, tt.filter)
-			}
-			if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: pql}); err != nil {
-				t.Fatal(err)
-			} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: tt.exp, Count: tt.cnt}) {
-				t.Fatalf("unexpected result, test %d: %s", i, spew.Sdump(result))
-			}
+		for i, tt := range tests {
+			var pql string
+			if tt.filter == "" {
+				pql = `Max(field=f)`
+			} else {
+				pql = fmt.Sprintf(`Max(%s, field=f)`, tt.filter)
+			}
+			if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: pql}); err != nil {
+				t.Fatal(err)
+			} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: tt.exp, Count: tt.cnt}) {
+				t.Fatalf("unexpected result, test %d: %s", i, spew.Sdump(result))
+			}
+		}
+	})
+}
+
+// Ensure a Sum() query can be executed.
+func TestExecutor_Execute_Sum(t *testing.T) {
+	c := test.MustRunCluster(t, 1)
+	defer c.Close()
+	hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idx.CreateField("x", pilosa.FieldOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idx.CreateField("foo", pilosa.FieldOptions{
+		Type: pilosa.FieldTypeInt,
+		Min:  10,
+		Max:  100,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idx.CreateField("bar", pilosa.FieldOptions{
+		Type: pilosa.FieldTypeInt,
+		Min:  0,
+		Max:  100000,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idx.CreateField("other", pilosa.FieldOptions{
+		Type: pilosa.FieldTypeInt,
+		Min:  0,
+		Max:  1000,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(0, x=0)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, x=0)
+
+		SetValue(col=0, foo=20)
+		SetValue(col=0, bar=2000)
+		SetValue(col=` + strconv.Itoa(ShardWidth) + `, foo=30)
+		SetValue(col=` + strconv.Itoa(ShardWidth+2) + `, foo=40)
+		SetValue(col=` + strconv.Itoa((5*ShardWidth)+100) + `, foo=50)
+		SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, foo=60)
+		SetValue(col=0, other=1000)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("NoFilter", func(t *testing.T) {
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=foo)`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 200, Count: 5}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+
+	t.Run("WithFilter", func(t *testing.T) {
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(Row(x=0), field=foo)`}); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 80, Count: 2}) {
+			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+	})
+}
+
+// Ensure Average(), Percentile() and Median() queries can be executed.
+func TestExecutor_Execute_Average_Percentile(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "foo", pilosa.OptFieldTypeInt(-10, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set values across several shards.
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(0, x=0)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, x=0)
+		Set(` + strconv.Itoa((2*ShardWidth)+2) + `, x=0)
+
+		SetValue(col=0, foo=20)
+		SetValue(col=` + strconv.Itoa(ShardWidth) + `, foo=-5)
+		SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, foo=60)
+		SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, foo=41)
+		SetValue(col=` + strconv.Itoa((5*ShardWidth)+100) + `, foo=50)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		query string
+		exp   pilosa.ValCount
+	}{
+		{query: `Average(field=foo)`, exp: pilosa.ValCount{Val: 33, FloatVal: 33.2, Count: 5}},
+		{query: `Average(Row(x=0), field=foo)`, exp: pilosa.ValCount{Val: 40, FloatVal: 40.333333333333336, Count: 3}},
+		{query: `Median(field=foo)`, exp: pilosa.ValCount{Val: 41, Count: 5}},
+		{query: `Median(Row(x=0), field=foo)`, exp: pilosa.ValCount{Val: 41, Count: 3}},
+		{query: `Percentile(field=foo, nth=0)`, exp: pilosa.ValCount{Val: -5, Count: 5}},
+		{query: `Percentile(field=foo, nth=20)`, exp: pilosa.ValCount{Val: -5, Count: 5}},
+		{query: `Percentile(field=foo, nth=75)`, exp: pilosa.ValCount{Val: 50, Count: 5}},
+		{query: `Percentile(field=foo, nth=99.5)`, exp: pilosa.ValCount{Val: 60, Count: 5}},
+		{query: `Percentile(Row(x=1), field=foo, nth=50)`, exp: pilosa.ValCount{}},
+	} {
+		t.Run(tt.query, func(t *testing.T) {
+			if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatal(err)
+			} else if !reflect.DeepEqual(result.Results[0], tt.exp) {
+				t.Fatalf("unexpected result: %s", spew.Sdump(result))
+			}
+		})
+	}
+
+	t.Run("ErrInvalidNth", func(t *testing.T) {
+		for _, query := range []string{`Percentile(field=foo)`, `Percentile(field=foo, nth=101)`, `Percentile(field=foo, nth="a")`} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); err == nil {
+				t.Fatalf("expected error for %s", query)
+			}
+		}
+	})
+
+	t.Run("ErrInternalCall", func(t *testing.T) {
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `PercentileCount(field=foo, lo=0, hi=100)`}); err == nil || !strings.Contains(err.Error(), "PercentileCount() is internal") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure decimal values can be written to and read from int fields with a scale.
+func TestExecutor_Execute_Decimal(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, -10, 1000)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set values across several shards.
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		SetValue(col=0, price=10.25)
+		SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, price=10.5)
+		SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, price=-3.75)
+		SetValue(col=` + strconv.Itoa((5*ShardWidth)+100) + `, price=20)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			exp   []uint64
+		}{
+			{query: `Range(price > 10.25)`, exp: []uint64{ShardWidth + 1, (5 * ShardWidth) + 100}},
+			{query: `Range(price <= 10.25)`, exp: []uint64{0, (2 * ShardWidth) + 2}},
+			{query: `Range(price == 20)`, exp: []uint64{(5 * ShardWidth) + 100}},
+			{query: `Range(price >< [10, 10.5])`, exp: []uint64{0, ShardWidth + 1}},
+		} {
+			if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatalf("%s: %s", tt.query, err)
+			} else if columns := result.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, tt.exp) {
+				t.Fatalf("%s: unexpected columns: %v", tt.query, columns)
+			}
+		}
+	})
+
+	t.Run("Aggregates", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			exp   pilosa.ValCount
+		}{
+			{query: `Sum(field=price)`, exp: pilosa.ValCount{Val: 37, FloatVal: 37, Count: 4}},
+			{query: `Min(field=price)`, exp: pilosa.ValCount{Val: -3, FloatVal: -3.75, Count: 1}},
+			{query: `Max(field=price)`, exp: pilosa.ValCount{Val: 20, FloatVal: 20, Count: 1}},
+			{query: `Average(field=price)`, exp: pilosa.ValCount{Val: 9, FloatVal: 9.25, Count: 4}},
+			{query: `Percentile(field=price, nth=0)`, exp: pilosa.ValCount{Val: -3, FloatVal: -3.75, Count: 4}},
+			{query: `Percentile(field=price, nth=75)`, exp: pilosa.ValCount{Val: 10, FloatVal: 10.5, Count: 4}},
+			{query: `Median(field=price)`, exp: pilosa.ValCount{Val: 10, FloatVal: 10.25, Count: 4}},
+		} {
+			if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatalf("%s: %s", tt.query, err)
+			} else if !reflect.DeepEqual(result.Results[0], tt.exp) {
+				t.Fatalf("%s: unexpected result: %s", tt.query, spew.Sdump(result.Results[0]))
+			}
+		}
+	})
+
+	t.Run("ErrInvalidBSIGroupValueScale", func(t *testing.T) {
+		for _, query := range []string{`SetValue(col=1, price=1.234)`, `Range(price > 1.001)`} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); errors.Cause(err) != pilosa.ErrInvalidBSIGroupValueScale {
+				t.Fatalf("%s: unexpected error: %v", query, err)
+			}
+		}
+	})
+
+	t.Run("ErrBSIGroupValueTooHigh", func(t *testing.T) {
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `SetValue(col=1, price=1000.01)`}); errors.Cause(err) != pilosa.ErrBSIGroupValueTooHigh {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure TopK() and BottomK() queries can be executed.
+func TestExecutor_Execute_TopK(t *testing.T) {
+	t.Run("ID", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeInt(-10, 1000)); err != nil {
+			t.Fatal(err)
+		}
+
+		// Set values across several shards.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(0, x=1)
+			Set(` + strconv.Itoa(ShardWidth+1) + `, x=1)
+			Set(` + strconv.Itoa((2*ShardWidth)+2) + `, x=1)
+
+			SetValue(col=0, price=20)
+			SetValue(col=1, price=500)
+			SetValue(col=` + strconv.Itoa(ShardWidth) + `, price=-5)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, price=600)
+			SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, price=500)
+			SetValue(col=` + strconv.Itoa((5*ShardWidth)+100) + `, price=50)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			query string
+			exp   []pilosa.ColumnValue
+		}{
+			{
+				query: `TopK(field=price, n=3)`,
+				exp:   []pilosa.ColumnValue{{ID: ShardWidth + 1, Value: 600}, {ID: 1, Value: 500}, {ID: (2 * ShardWidth) + 2, Value: 500}},
+			},
+			{
+				query: `BottomK(field=price, n=2)`,
+				exp:   []pilosa.ColumnValue{{ID: ShardWidth, Value: -5}, {ID: 0, Value: 20}},
+			},
+			{
+				query: `TopK(field=price, n=2, filter=Row(x=1))`,
+				exp:   []pilosa.ColumnValue{{ID: ShardWidth + 1, Value: 600}, {ID: (2 * ShardWidth) + 2, Value: 500}},
+			},
+			{
+				query: `BottomK(field=price, n=10, filter=Row(x=1))`,
+				exp:   []pilosa.ColumnValue{{ID: 0, Value: 20}, {ID: (2 * ShardWidth) + 2, Value: 500}, {ID: ShardWidth + 1, Value: 600}},
+			},
+			{
+				query: `TopK(field=price, n=2, filter=Row(x=2))`,
+				exp:   []pilosa.ColumnValue{},
+			},
+		} {
+			t.Run(tt.query, func(t *testing.T) {
+				if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+					t.Fatal(err)
+				} else if diff := cmp.Diff(result.Results[0], tt.exp); diff != "" {
+					t.Fatal(diff)
+				}
+			})
+		}
+	})
+
+	t.Run("Decimal", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, -10, 1000)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			SetValue(col=0, price=10.25)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, price=-3.75)
+			SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, price=20)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `TopK(field=price, n=2)`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], []pilosa.ColumnValue{
+			{ID: (2 * ShardWidth) + 2, Value: 20, FloatValue: 20},
+			{ID: 0, Value: 10, FloatValue: 10.25},
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `BottomK(field=price, n=1)`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], []pilosa.ColumnValue{{ID: ShardWidth + 1, Value: -3, FloatValue: -3.75}}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		if idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("price", pilosa.FieldOptions{Type: pilosa.FieldTypeInt, Min: 0, Max: 100}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			SetValue(col="a", price=10)
+			SetValue(col="b", price=30)
+			SetValue(col="c", price=20)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `TopK(field=price, n=2)`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], []pilosa.ColumnValue{{Key: "b", Value: 30}, {Key: "c", Value: 20}}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		hldr.SetBit("i", "f", 10, 3)
+
+		for _, query := range []string{`TopK(n=2)`, `TopK(field=f, n=2)`, `TopK(field=f)`, `BottomK(field=f, n=2, filter=1)`} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); err == nil {
+				t.Fatalf("expected error for %s", query)
+			}
+		}
+	})
+}
+
+func TestExecutor_Execute_Extract(t *testing.T) {
+	v := func(n int64) *int64 { return &n }
+
+	t.Run("ID", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "age", pilosa.OptFieldTypeInt(0, 150)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "score", pilosa.OptFieldTypeInt(-100, 100)); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(1, x=1)
+			Set(` + strconv.Itoa(ShardWidth+1) + `, x=1)
+			Set(` + strconv.Itoa((2*ShardWidth)+2) + `, x=1)
+			Set(` + strconv.Itoa((2*ShardWidth)+3) + `, x=2)
+
+			SetValue(col=1, age=30)
+			SetValue(col=1, score=-20)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, age=45)
+			SetValue(col=` + strconv.Itoa((2*ShardWidth)+2) + `, score=80)
+			SetValue(col=` + strconv.Itoa((2*ShardWidth)+3) + `, age=12)
+			SetColumnAttrs(1, name="bob")
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Extract(Row(x=1), fields=[age, score])`, ColumnAttrs: true})
+		if err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], pilosa.ExtractedTable{
+			Fields: []string{"age", "score"},
+			Columns: []pilosa.ExtractedColumn{
+				{ID: 1, Values: []*int64{v(30), v(-20)}},
+				{ID: ShardWidth + 1, Values: []*int64{v(45), nil}},
+				{ID: (2 * ShardWidth) + 2, Values: []*int64{nil, v(80)}},
+			},
+		}); diff != "" {
+			t.Fatal(diff)
+		} else if !reflect.DeepEqual(result.ColumnAttrSets, []*pilosa.ColumnAttrSet{{ID: 1, Attrs: map[string]interface{}{"name": "bob"}}}) {
+			t.Fatalf("unexpected column attrs: %#v", result.ColumnAttrSets)
+		}
+
+		// Ensure columns are still extracted when excluding columns.
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Extract(Row(x=1), fields=[age])`, ExcludeColumns: true}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], pilosa.ExtractedTable{
+			Fields: []string{"age"},
+			Columns: []pilosa.ExtractedColumn{
+				{ID: 1, Values: []*int64{v(30)}},
+				{ID: ShardWidth + 1, Values: []*int64{v(45)}},
+				{ID: (2 * ShardWidth) + 2, Values: []*int64{nil}},
+			},
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Decimal", func(t *testing.T) {
+		f := func(n float64) *float64 { return &n }
+
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+
+		if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "x", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "age", pilosa.OptFieldTypeInt(0, 150)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, -10, 1000)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(1, x=1)
+			Set(` + strconv.Itoa(ShardWidth+1) + `, x=1)
+			SetValue(col=1, age=30)
+			SetValue(col=1, price=10.25)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, price=-3.75)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Extract(Row(x=1), fields=[age, price])`}); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(result.Results[0], pilosa.ExtractedTable{
+			Fields: []string{"age", "price"},
+			Columns: []pilosa.ExtractedColumn{
+				{ID: 1, Values: []*int64{v(30), v(10)}, FloatValues: []*float64{nil, f(10.25)}},
+				{ID: ShardWidth + 1, Values: []*int64{nil, v(-3)}, FloatValues: []*float64{nil, f(-3.75)}},
+			},
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+		if idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("x", pilosa.FieldOptions{Keys: true}); err != nil {
+			t.Fatal(err)
+		} else if _, err := idx.CreateField("age", pilosa.FieldOptions{Type: pilosa.FieldTypeInt, Min: 0, Max: 100}); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set("a", x="foo")
+			Set("b", x="foo")
+			SetValue(col="a", age=10)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Extract(Row(x="foo"), fields=[age])`}); err != nil {
+			t.Fatal(err)
+		} else if table := result.Results[0].(pilosa.ExtractedTable); len(table.Columns) != 2 {
+			t.Fatalf("unexpected columns: %#v", table.Columns)
+		} else {
+			values := make(map[string]*int64)
+			for _, col := range table.Columns {
+				values[col.Key] = col.Values[0]
+			}
+			if diff := cmp.Diff(values, map[string]*int64{"a": v(10), "b": nil}); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		c := test.MustRunCluster(t, 1)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		hldr.SetBit("i", "f", 10, 3)
+
+		for _, query := range []string{`Extract(Row(f=10))`, `Extract(Row(f=10), fields=[f])`, `Extract(Row(f=10), fields=[missing])`, `Extract(fields=[f])`} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: query}); err == nil {
+				t.Fatalf("expected error for %s", query)
+			}
+		}
+	})
+}
+
+// Ensure a Shift() query can be executed across shards.
+func TestExecutor_Execute_Shift(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(0, f=1)
+		Set(` + strconv.Itoa(ShardWidth-1) + `, f=1)
+		Set(` + strconv.Itoa(2*ShardWidth-1) + `, f=1)
+		Set(` + strconv.Itoa(2*ShardWidth) + `, f=2)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		query string
+		exp   interface{}
+	}{
+		{query: `Shift(Row(f=1), n=1)`, exp: []uint64{1, ShardWidth, 2 * ShardWidth}},
+		{query: `Shift(Row(f=1), n=-1)`, exp: []uint64{ShardWidth - 2, 2*ShardWidth - 2}},
+		{query: `Shift(Shift(Row(f=1), n=1), n=1)`, exp: []uint64{2, ShardWidth + 1, 2*ShardWidth + 1}},
+		{query: `Union(Shift(Row(f=1), n=1), Row(f=2))`, exp: []uint64{1, ShardWidth, 2 * ShardWidth}},
+		{query: `Count(Shift(Row(f=1), n=1))`, exp: uint64(3)},
+		{query: `Count(Union(Shift(Row(f=1), n=1), Row(f=2)))`, exp: uint64(3)},
+	} {
+		result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query})
+		if err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		}
+		v := result.Results[0]
+		if row, ok := v.(*pilosa.Row); ok {
+			v = row.Columns()
+		}
+		if !reflect.DeepEqual(v, tt.exp) {
+			t.Fatalf("%s: unexpected result: %v", tt.query, v)
+		}
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   string
+		}{
+			{query: `Shift(Row(f=1))`, err: `Shift() requires an integer n argument`},
+			{query: `Shift(n=1)`, err: `Shift() requires a single row input`},
+			{query: `Intersect(Shift(Row(f=1), n=1), Row(f=2))`, err: `Shift() can only be nested in Count(), Union() or Shift()`},
+			{query: `Count(Intersect(Union(Shift(Row(f=1), n=1)), Row(f=2)))`, err: `Shift() can only be nested in Count(), Union() or Shift()`},
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("%s: unexpected error: %v", tt.query, err)
+			}
+		}
+	})
+}
+
+// Ensure a Store() query replaces a row across shards.
+func TestExecutor_Execute_Store(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "b", "target"} {
+		if _, err := c[0].API.CreateField(context.Background(), "i", name, pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c[0].API.CreateField(context.Background(), "i", "v", pilosa.OptFieldTypeInt(0, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(1, a=1)
+		Set(2, a=1)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, a=1)
+		Set(` + strconv.Itoa(2*ShardWidth+1) + `, a=1)
+		Set(2, b=2)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, b=2)
+		Set(` + strconv.Itoa(2*ShardWidth+1) + `, b=2)
+		Set(5, target=1)
+		Set(` + strconv.Itoa(3*ShardWidth+5) + `, target=1)
+		Set(5, target=2)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Store(Intersect(Row(a=1), Row(b=2)), target=1)`}); err != nil {
+		t.Fatal(err)
+	} else if !result.Results[0].(bool) {
+		t.Fatalf("expected change: %v", result.Results[0])
+	}
+
+	// Existing columns in every shard are replaced and other rows are untouched.
+	if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Row(target=1) Row(target=2)`}); err != nil {
+		t.Fatal(err)
+	} else if cols := result.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(cols, []uint64{2, ShardWidth + 1, 2*ShardWidth + 1}) {
+		t.Fatalf("unexpected columns: %v", cols)
+	} else if cols := result.Results[1].(*pilosa.Row).Columns(); !reflect.DeepEqual(cols, []uint64{5}) {
+		t.Fatalf("unexpected columns: %v", cols)
+	}
+
+	// Storing the same row again is not a change.
+	if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Store(Intersect(Row(a=1), Row(b=2)), target=1)`}); err != nil {
+		t.Fatal(err)
+	} else if result.Results[0].(bool) {
+		t.Fatal("expected no change")
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   string
+		}{
+			{query: `Store(target=1)`, err: `Store() requires a single bitmap input`},
+			{query: `Store(Row(a=1))`, err: `Store() argument required: field`},
+			{query: `Store(Row(a=1), v=1)`, err: `Store() cannot write to int field`},
+			{query: `Store(Row(a=1), missing=1)`, err: pilosa.ErrFieldNotFound.Error()},
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("%s: unexpected error: %v", tt.query, err)
+			}
+		}
+	})
+}
+
+// Ensure a range query can be executed.
+func TestExecutor_Execute_Range(t *testing.T) {
+	c := test.MustRunCluster(t, 1)
+	defer c.Close()
+	hldr := test.Holder{Holder: c[0].Server.Holder()}
+
+	// Create index.
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+
+	// Create field.
+	if _, err := index.CreateFieldIfNotExists("f", pilosa.FieldOptions{
+		Type:        pilosa.FieldTypeTime,
+		TimeQuantum: pilosa.TimeQuantum("YMDH"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set columns.
+	cc := `
+        Set(2, f=1, 1999-12-31T00:00)
+        Set(3, f=1, 2000-01-01T00:00)
+        Set(4, f=1, 2000-01-02T00:00)
+        Set(5, f=1, 2000-02-01T00:00)
+        Set(6, f=1, 2001-01-01T00:00)
+        Set(7, f=1, 2002-01-01T02:00)
+
+        Set(2, f=1, 1999-12-30T00:00)
+        Set(2, f=1, 2002-02-01T00:00)
+        Set(2, f=10, 2001-01-01T00:00)
+	`
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: cc}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Standard", func(t *testing.T) {
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f=1, 1999-12-31T00:00, 2002-01-01T03:00)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2, 3, 4, 5, 6, 7}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		}
+	})
+
+	t.Run("OpenEnded", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			exp   []uint64
+		}{
+			{query: `Range(f=1, from=2001-01-01T00:00)`, exp: []uint64{2, 6, 7}},
+			{query: `Range(f=1, to="2000-01-01T00:00")`, exp: []uint64{2}},
+			{query: `Range(f=1, from=2000-01-02T00:00, to=2000-02-01T00:00)`, exp: []uint64{4}},
+		} {
+			if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatal(err)
+			} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, tt.exp) {
+				t.Fatalf("%s: unexpected columns: %+v", tt.query, columns)
+			}
+		}
+	})
+
+	t.Run("Relative", func(t *testing.T) {
+		now := time.Now().UTC()
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: fmt.Sprintf(`
+			Set(100, f=2, %s)
+			Set(101, f=2, %s)
+		`, now.Add(-2*time.Hour).Format(pilosa.TimeFormat), now.AddDate(0, 0, -3).Format(pilosa.TimeFormat))}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			query string
+			exp   []uint64
+		}{
+			{query: `Range(f=2, from=-1d, to=now)`, exp: []uint64{100}},
+			{query: `Range(f=2, from=-1w)`, exp: []uint64{100, 101}},
+			{query: `Range(f=2, to=-2d)`, exp: []uint64{101}},
+			{query: `Range(f=2, from=-1d, to=+1h)`, exp: []uint64{100}},
+		} {
+			if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatal(err)
+			} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, tt.exp) {
+				t.Fatalf("%s: unexpected columns: %+v", tt.query, columns)
+			}
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f=2, from=later)`}); err == nil || !strings.Contains(err.Error(), `invalid time: "later"`) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Minute", func(t *testing.T) {
+		if _, err := index.CreateFieldIfNotExists("m", pilosa.FieldOptions{
+			Type:        pilosa.FieldTypeTime,
+			TimeQuantum: pilosa.TimeQuantum("YMDHT"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(1, m=1, 2000-01-01T00:58)
+			Set(2, m=1, 2000-01-01T00:59)
+			Set(3, m=1, 2000-01-01T01:00)
+			Set(4, m=1, 2000-01-01T01:30)
+			Set(5, m=1, 2000-01-02T01:00)
+			Clear(3, m=1)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			query string
+			exp   []uint64
+		}{
+			{query: `Range(m=1, 2000-01-01T00:59, 2000-01-01T01:31)`, exp: []uint64{2, 4}},
+			{query: `Range(m=1, 2000-01-01T00:58, 2000-01-01T00:59)`, exp: []uint64{1}},
+			{query: `Range(m=1, 2000-01-01T01:00, 2000-01-01T01:30)`, exp: []uint64{}},
+			{query: `Range(m=1, 2000-01-01T00:00, 2000-01-03T00:00)`, exp: []uint64{1, 2, 4, 5}},
+		} {
+			if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err != nil {
+				t.Fatal(err)
+			} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, tt.exp) {
+				t.Fatalf("%s: unexpected columns: %+v", tt.query, columns)
+			}
+		}
+	})
+
+	t.Run("NoStandardView", func(t *testing.T) {
+		if _, err := c[0].API.CreateField(context.Background(), "i", "ns", pilosa.OptFieldTypeTime("YMD"), pilosa.OptFieldNoStandardView()); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.CreateField(context.Background(), "i", "nss", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize), pilosa.OptFieldNoStandardView()); err == nil {
+			t.Fatal("expected error creating set field without a standard view")
+		}
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(2, ns=1, 1999-12-31T00:00)
+			Set(3, ns=1, 2000-03-01T00:00)
+			Set(4, ns=1, 2010-01-01T00:00)
+			Set(5, ns=2, 2010-01-01T00:00)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Row() reads the union of the time views.
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Row(ns=1) Range(ns=1, 2000-01-01T00:00, 2011-01-01T00:00)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2, 3, 4}) {
+			t.Fatalf("unexpected row columns: %+v", columns)
+		} else if columns := res.Results[1].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{3, 4}) {
+			t.Fatalf("unexpected range columns: %+v", columns)
+		}
+
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Set(6, ns=1)`}); errors.Cause(err) != pilosa.ErrTimestampRequired {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Rows(ns)`}); err == nil || !strings.Contains(err.Error(), "without a standard view") {
+			t.Fatalf("unexpected rows error: %v", err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `TopN(ns)`}); err == nil || !strings.Contains(err.Error(), "without a standard view") {
+			t.Fatalf("unexpected topn error: %v", err)
+		}
+	})
+
+	t.Run("Epoch", func(t *testing.T) {
+		if _, err := index.CreateFieldIfNotExists("ep", pilosa.FieldOptions{
+			Type:        pilosa.FieldTypeTime,
+			TimeQuantum: pilosa.TimeQuantum("YMDH"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		// 1539696000 is 2018-10-16T13:20 UTC.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Set(2, ep=1, 1539696000)`}); err != nil {
+			t.Fatal(err)
+		} else if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(ep=1, 2018-10-16T13:00, 2018-10-16T14:00)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		}
+
+		// Milliseconds are rejected rather than read as a far future year.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Set(3, ep=1, 1539696000000)`}); err == nil || !strings.Contains(err.Error(), "timestamp out of range") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Rollup", func(t *testing.T) {
+		if _, err := index.CreateFieldIfNotExists("ru", pilosa.FieldOptions{
+			Type:        pilosa.FieldTypeTime,
+			TimeQuantum: pilosa.TimeQuantum("YMDH"),
+			TimeRollup:  pilosa.TimeRollup("H=1d"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(2, ru=1, 2000-01-01T05:00)
+			Set(3, ru=1, 2000-01-01T20:00)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Hours older than a day are read from their daily view.
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(ru=1, 2000-01-01T05:00, 2000-01-01T06:00)`})
+		if err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2, 3}) {
+			t.Fatalf("unexpected columns: %+v", columns)
+		} else if exp := []string{"Range(ru) reads views folded by the field's time rollup and covers 2000-01-01T00:00 to 2000-01-02T00:00"}; !reflect.DeepEqual(res.Warnings, exp) {
+			t.Fatalf("unexpected warnings: %q", res.Warnings)
+		}
+
+		// A range of whole days is as precise as it was asked for.
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(ru=1, 2000-01-01T00:00, 2000-01-02T00:00)`}); err != nil {
+			t.Fatal(err)
+		} else if len(res.Warnings) != 0 {
+			t.Fatalf("unexpected warnings: %q", res.Warnings)
+		}
+
+		// Hourly counts cannot be read from a daily view.
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `CountByTime(Row(ru=1), from="2000-01-01T00:00", to="2000-01-02T00:00", unit=H)`}); err != nil {
+			t.Fatal(err)
+		} else if len(res.Warnings) != 1 || !strings.HasPrefix(res.Warnings[0], "CountByTime(ru) counts H buckets before ") {
+			t.Fatalf("unexpected warnings: %q", res.Warnings)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Clear( 2, f=1)`}); err != nil {
+			t.Fatal(err)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f=1, 1999-12-31T00:00, 2002-01-01T03:00)`}); err != nil {
+			t.Fatal(err)
+		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{3, 4, 5, 6, 7}) {
+			t.Fatalf("unexpected columns: %+v", columns)
 		}
 	})
 }
 
-// Ensure a Sum() query can be executed.
-func TestExecutor_Execute_Sum(t *testing.T) {
-	c := test.MustRunCluster(t, 1)
+// Ensure a CountByTime() query returns a count for each time bucket.
+func TestExecutor_Execute_CountByTime(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
 	defer c.Close()
-	hldr := test.Holder{Holder: c[0].Server.Holder()}
-
-	idx, err := hldr.CreateIndex("i", pilosa.IndexOptions{})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if _, err := idx.CreateField("x", pilosa.FieldOptions{}); err != nil {
-		t.Fatal(err)
-	}
 
-	if _, err := idx.CreateField("foo", pilosa.FieldOptions{
-		Type: pilosa.FieldTypeInt,
-		Min:  10,
-		Max:  100,
-	}); err != nil {
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
 		t.Fatal(err)
 	}
-
-	if _, err := idx.CreateField("bar", pilosa.FieldOptions{
-		Type: pilosa.FieldTypeInt,
-		Min:  0,
-		Max:  100000,
-	}); err != nil {
+	if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeTime("YMDH")); err != nil {
 		t.Fatal(err)
 	}
-
-	if _, err := idx.CreateField("other", pilosa.FieldOptions{
-		Type: pilosa.FieldTypeInt,
-		Min:  0,
-		Max:  1000,
-	}); err != nil {
+	if _, err := c[0].API.CreateField(context.Background(), "i", "g", pilosa.OptFieldTypeSet(pilosa.DefaultCacheType, pilosa.DefaultCacheSize)); err != nil {
 		t.Fatal(err)
 	}
 
 	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
-		Set(0, x=0)
-		Set(` + strconv.Itoa(ShardWidth+1) + `, x=0)
-
-		SetValue(col=0, foo=20)
-		SetValue(col=0, bar=2000)
-		SetValue(col=` + strconv.Itoa(ShardWidth) + `, foo=30)
-		SetValue(col=` + strconv.Itoa(ShardWidth+2) + `, foo=40)
-		SetValue(col=` + strconv.Itoa((5*ShardWidth)+100) + `, foo=50)
-		SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, foo=60)
-		SetValue(col=0, other=1000)
+		Set(1, f=1, 2000-01-01T00:00)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, f=1, 2000-01-01T05:00)
+		Set(` + strconv.Itoa(2*ShardWidth+1) + `, f=1, 2000-01-03T10:00)
+		Set(2, f=1, 2000-01-03T11:00)
+		Set(3, f=1, 2000-01-05T00:00)
+		Set(4, f=2, 2000-01-02T00:00)
 	`}); err != nil {
 		t.Fatal(err)
 	}
 
-	t.Run("NoFilter", func(t *testing.T) {
-		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=foo)`}); err != nil {
+	t.Run("Day", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `CountByTime(Row(f=1), from="2000-01-01T00:00", to="2000-01-04T00:00", unit=D)`})
+		if err != nil {
 			t.Fatal(err)
-		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 200, Count: 5}) {
-			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+		exp := []pilosa.TimeCount{
+			{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Count: 2},
+			{Time: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Count: 0},
+			{Time: time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC), Count: 2},
+		}
+		if counts := res.Results[0].([]pilosa.TimeCount); !reflect.DeepEqual(counts, exp) {
+			t.Fatalf("unexpected counts: %+v", counts)
 		}
 	})
 
-	t.Run("WithFilter", func(t *testing.T) {
-		if result, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(Row(x=0), field=foo)`}); err != nil {
+	t.Run("Hour", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `CountByTime(Row(f=1), from="2000-01-03T10:30", to="2000-01-03T12:00", unit=H)`})
+		if err != nil {
 			t.Fatal(err)
-		} else if !reflect.DeepEqual(result.Results[0], pilosa.ValCount{Val: 80, Count: 2}) {
-			t.Fatalf("unexpected result: %s", spew.Sdump(result))
+		}
+		exp := []pilosa.TimeCount{
+			{Time: time.Date(2000, 1, 3, 10, 0, 0, 0, time.UTC), Count: 1},
+			{Time: time.Date(2000, 1, 3, 11, 0, 0, 0, time.UTC), Count: 1},
+		}
+		if counts := res.Results[0].([]pilosa.TimeCount); !reflect.DeepEqual(counts, exp) {
+			t.Fatalf("unexpected counts: %+v", counts)
 		}
 	})
-}
-
-// Ensure a range query can be executed.
-func TestExecutor_Execute_Range(t *testing.T) {
-	c := test.MustRunCluster(t, 1)
-	defer c.Close()
-	hldr := test.Holder{Holder: c[0].Server.Holder()}
 
-	// Create index.
-	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
-
-	// Create field.
-	if _, err := index.CreateFieldIfNotExists("f", pilosa.FieldOptions{
-		Type:        pilosa.FieldTypeTime,
-		TimeQuantum: pilosa.TimeQuantum("YMDH"),
-	}); err != nil {
-		t.Fatal(err)
-	}
-
-	// Set columns.
-	cc := `
-        Set(2, f=1, 1999-12-31T00:00)
-        Set(3, f=1, 2000-01-01T00:00)
-        Set(4, f=1, 2000-01-02T00:00)
-        Set(5, f=1, 2000-02-01T00:00)
-        Set(6, f=1, 2001-01-01T00:00)
-        Set(7, f=1, 2002-01-01T02:00)
+	t.Run("TimeZone", func(t *testing.T) {
+		if _, err := c[0].API.CreateField(context.Background(), "i", "z", pilosa.OptFieldTypeTimeZone("YMDH", "America/New_York")); err != nil {
+			t.Fatal(err)
+		}
 
-        Set(2, f=1, 1999-12-30T00:00)
-        Set(2, f=1, 2002-02-01T00:00)
-        Set(2, f=10, 2001-01-01T00:00)
-	`
-	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: cc}); err != nil {
-		t.Fatal(err)
-	}
+		// Both bits fall on 2000-01-01 in New York but on different days in UTC.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			Set(1, z=1, 2000-01-01T12:00)
+			Set(` + strconv.Itoa(ShardWidth+1) + `, z=1, 2000-01-02T03:00)
+		`}); err != nil {
+			t.Fatal(err)
+		}
 
-	t.Run("Standard", func(t *testing.T) {
-		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f=1, 1999-12-31T00:00, 2002-01-01T03:00)`}); err != nil {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			CountByTime(Row(z=1), from="2000-01-01T05:00", to="2000-01-03T05:00", unit=D)
+			Range(z=1, 2000-01-01T05:00, 2000-01-02T05:00)
+		`})
+		if err != nil {
 			t.Fatal(err)
-		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{2, 3, 4, 5, 6, 7}) {
+		}
+		loc, _ := time.LoadLocation("America/New_York")
+		counts := res.Results[0].([]pilosa.TimeCount)
+		if len(counts) != 2 || counts[0].Count != 2 || counts[1].Count != 0 {
+			t.Fatalf("unexpected counts: %+v", counts)
+		} else if !counts[0].Time.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, loc)) || counts[0].Time.Location().String() != "America/New_York" {
+			t.Fatalf("unexpected bucket time: %s", counts[0].Time)
+		}
+		if columns := res.Results[1].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{1, ShardWidth + 1}) {
 			t.Fatalf("unexpected columns: %+v", columns)
 		}
 	})
 
-	t.Run("Clear", func(t *testing.T) {
-		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Clear( 2, f=1)`}); err != nil {
+	t.Run("RelativeTimes", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `CountByTime(Row(f=1), from=-2d, to=now, unit=D)`})
+		if err != nil {
 			t.Fatal(err)
 		}
+		counts := res.Results[0].([]pilosa.TimeCount)
+		if n := len(counts); n < 2 || n > 3 {
+			t.Fatalf("unexpected buckets: %+v", counts)
+		}
+		for _, tc := range counts {
+			if tc.Count != 0 {
+				t.Fatalf("unexpected counts: %+v", counts)
+			}
+		}
+	})
 
-		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Range(f=1, 1999-12-31T00:00, 2002-01-01T03:00)`}); err != nil {
-			t.Fatal(err)
-		} else if columns := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(columns, []uint64{3, 4, 5, 6, 7}) {
-			t.Fatalf("unexpected columns: %+v", columns)
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			query string
+			err   string
+		}{
+			{query: `CountByTime(Union(Row(f=1)), from="2000-01-01T00:00", to="2000-01-02T00:00", unit=D)`, err: "requires a single Row() input"},
+			{query: `CountByTime(Row(f=1), from="2000-01-01T00:00", unit=D)`, err: "from and to times required"},
+			{query: `CountByTime(Row(f=1), from="2000-01-01T00:00", to="2000-01-02T00:00", unit=W)`, err: "unit must be one of"},
+			{query: `CountByTime(Row(f=1), from="2000-01-01T00:00", to="2000-01-02T00:00", unit=T)`, err: "not in the time quantum"},
+			{query: `CountByTime(Row(g=1), from="2000-01-01T00:00", to="2000-01-02T00:00", unit=D)`, err: "not in the time quantum"},
+			{query: `CountByTime(Row(f=1), from=2000-01-01T00:00, to=2002-01-01T00:00, unit=H)`, err: "more than 10000 buckets"},
+		} {
+			if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: tt.query}); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("%s: expected error %q, got %v", tt.query, tt.err, err)
+			}
 		}
 	})
 }
@@ -1233,6 +2846,35 @@ Set(4500001, fn=4)
 		}
 	})
 
+	t.Run("remote keys", func(t *testing.T) {
+		for _, hldr := range []test.Holder{hldr0, hldr1} {
+			if _, err := hldr.Index("i").CreateField("fk", pilosa.FieldOptions{Keys: true, CacheType: pilosa.CacheTypeRanked, CacheSize: 100}); err != nil {
+				t.Fatalf("creating field: %v", err)
+			}
+		}
+
+		// Keys are translated by the node which receives the query, so
+		// the remote node must neither translate the call nor its result.
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: fmt.Sprintf(`
+Set(1, fk="a")
+Set(%d, fk="a")
+Set(%d, fk="b")
+`, ShardWidth+1, ShardWidth+2)}); err != nil {
+			t.Fatalf("querying remote: %v", err)
+		} else if err := c[0].API.RecalculateCaches(context.Background()); err != nil {
+			t.Fatalf("recalcing caches: %v", err)
+		}
+
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `TopN(fk, n=2)`}); err != nil {
+			t.Fatalf("topn querying: %v", err)
+		} else if !reflect.DeepEqual(res.Results, []interface{}{[]pilosa.Pair{
+			{Key: "a", Count: 2},
+			{Key: "b", Count: 1},
+		}}) {
+			t.Fatalf("topn wrong results: %v", res.Results)
+		}
+	})
+
 	t.Run("remote setrowattrs", func(t *testing.T) {
 		if _, err := c[1].API.Query(context.Background(), &pilosa.QueryRequest{
 			Index: "i",
@@ -1260,6 +2902,240 @@ func TestExecutor_Execute_ErrMaxWritesPerRequest(t *testing.T) {
 	}
 }
 
+// Ensure a query stops once it runs past its timeout.
+func TestExecutor_Execute_Timeout(t *testing.T) {
+	t.Run("Query", func(t *testing.T) {
+		c := test.MustRunCluster(t, 3)
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=1))`, Timeout: time.Nanosecond}); errors.Cause(err) != pilosa.ErrQueryTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		c := test.MustNewCluster(t, 1)
+		c[0].Config.QueryTimeout.Max = toml.Duration(time.Nanosecond)
+		if err := c.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=1))`, Timeout: time.Hour}); errors.Cause(err) != pilosa.ErrQueryTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		c := test.MustNewCluster(t, 1)
+		c[0].Config.QueryTimeout.Default = toml.Duration(time.Nanosecond)
+		if err := c.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+		hldr := test.Holder{Holder: c[0].Server.Holder()}
+		index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+		if _, err := index.CreateField("f", pilosa.FieldOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=1))`}); errors.Cause(err) != pilosa.ErrQueryTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=1))`, Timeout: time.Hour}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// Ensure queries can be listed and cancelled across the cluster.
+func TestExecutor_Execute_Cancel(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+	hldr := test.Holder{Holder: c[0].Server.Holder()}
+	hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c[0].API.Query(ctx, &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=1))`}); errors.Cause(err) != pilosa.ErrQueryCancelled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Queries leave the registry once they return.
+	if queries, errs := c[1].API.ActiveQueries(context.Background()); errs != nil {
+		t.Fatal(errs)
+	} else if len(queries) != 0 {
+		t.Fatalf("unexpected queries: %+v", queries)
+	}
+
+	// Every node is asked for a query before it is reported missing.
+	if err := c[1].API.CancelQuery(context.Background(), c[0].API.LocalID()+"-1"); errors.Cause(err) != pilosa.NewNotFoundError(pilosa.ErrQueryNotFound) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure queries can be listed and cancelled while a node is unreachable.
+func TestExecutor_Execute_Cancel_Unreachable(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c[0].Close()
+	defer c[1].Close()
+
+	down := c[2].API.LocalID()
+	if err := c[2].Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The reachable nodes are listed and the unreachable one reported.
+	if queries, errs := c[1].API.ActiveQueries(context.Background()); len(queries) != 0 {
+		t.Fatalf("unexpected queries: %+v", queries)
+	} else if len(errs) != 1 || errs[down] == nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	// A query found on no reachable node reports the unreachable one.
+	if err := c[1].API.CancelQuery(context.Background(), c[0].API.LocalID()+"-1"); err == nil || !strings.Contains(err.Error(), down) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure a query can return the timings of its calls across the cluster.
+func TestExecutor_Execute_Profile(t *testing.T) {
+	c := test.MustRunCluster(t, 3)
+	defer c.Close()
+
+	if _, err := c[0].API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := c[0].API.CreateField(context.Background(), "i", "f", pilosa.OptFieldTypeSet(pilosa.CacheTypeRanked, pilosa.DefaultCacheSize)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+		Set(1, f=10)
+		Set(` + strconv.Itoa(ShardWidth+1) + `, f=10)
+		Set(` + strconv.Itoa(2*ShardWidth+1) + `, f=10)
+	`}); err != nil {
+		t.Fatal(err)
+	}
+
+	// shardProfiles returns the profiles of each shard of a call.
+	shardProfiles := func(cp *pilosa.CallProfile) map[uint64]*pilosa.ShardProfile {
+		m := make(map[uint64]*pilosa.ShardProfile)
+		for _, np := range cp.Nodes {
+			if np.Remote != (np.ID != c[0].API.LocalID()) {
+				t.Fatalf("unexpected remote for node %s: %v", np.ID, np.Remote)
+			}
+			for _, sp := range np.Shards {
+				m[sp.Shard] = sp
+			}
+		}
+		return m
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		if res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=10))`}); err != nil {
+			t.Fatal(err)
+		} else if res.Profile != nil {
+			t.Fatalf("unexpected profile: %+v", res.Profile)
+		}
+	})
+
+	t.Run("Row", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=10))`, Profile: true})
+		if err != nil {
+			t.Fatal(err)
+		} else if res.Results[0] != uint64(3) {
+			t.Fatalf("unexpected result: %v", res.Results[0])
+		} else if len(res.Profile) != 1 {
+			t.Fatalf("unexpected profile: %+v", res.Profile)
+		} else if cp := res.Profile[0]; cp.Call != "Count(Row(f=10))" || cp.Duration <= 0 {
+			t.Fatalf("unexpected call profile: %+v", cp)
+		}
+
+		shards := shardProfiles(res.Profile[0])
+		if len(shards) != 3 {
+			t.Fatalf("unexpected shards: %+v", shards)
+		}
+		for shard, sp := range shards {
+			if !reflect.DeepEqual(sp.Fragments, []string{"f/standard"}) {
+				t.Fatalf("unexpected fragments for shard %d: %v", shard, sp.Fragments)
+			} else if sp.Containers+sp.RowCacheHits == 0 {
+				t.Fatalf("expected shard %d to read containers or the row cache", shard)
+			}
+		}
+
+		// The same row is served by the row cache the second time.
+		res, err = c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Row(f=10))`, Profile: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for shard, sp := range shardProfiles(res.Profile[0]) {
+			if sp.RowCacheHits != 1 || sp.Containers != 0 {
+				t.Fatalf("unexpected reads for shard %d: %+v", shard, sp)
+			}
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Count(Union(Row(f=10), Row(f=11)))`, Profile: true})
+		if err != nil {
+			t.Fatal(err)
+		} else if len(res.Profile) != 1 || len(res.Profile[0].Children) != 1 {
+			t.Fatalf("unexpected profile: %+v", res.Profile)
+		}
+
+		union := res.Profile[0].Children[0]
+		if union.Call != "Union(Row(f=10), Row(f=11))" || union.Duration <= 0 {
+			t.Fatalf("unexpected union profile: %+v", union)
+		} else if len(shardProfiles(union)) != 3 {
+			t.Fatalf("unexpected union shards: %+v", shardProfiles(union))
+		} else if len(union.Children) != 2 {
+			t.Fatalf("unexpected union children: %+v", union.Children)
+		}
+		for i, call := range []string{"Row(f=10)", "Row(f=11)"} {
+			if cp := union.Children[i]; cp.Call != call {
+				t.Fatalf("unexpected child call: %s", cp.Call)
+			} else if len(shardProfiles(cp)) != 3 {
+				t.Fatalf("unexpected shards for %s: %+v", call, shardProfiles(cp))
+			}
+		}
+	})
+
+	t.Run("BSI", func(t *testing.T) {
+		if _, err := c[0].API.CreateField(context.Background(), "i", "v", pilosa.OptFieldTypeInt(0, 1000)); err != nil {
+			t.Fatal(err)
+		} else if _, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `
+			SetValue(col=1, v=10)
+			SetValue(col=` + strconv.Itoa(ShardWidth+1) + `, v=20)
+		`}); err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=v)`, Profile: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		shards := shardProfiles(res.Profile[0])
+		for _, shard := range []uint64{0, 1} {
+			if sp := shards[shard]; sp == nil || sp.Containers == 0 {
+				t.Fatalf("expected shard %d to read containers: %+v", shard, sp)
+			}
+		}
+	})
+
+	t.Run("TopN", func(t *testing.T) {
+		res, err := c[0].API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `TopN(f, n=1)`, Profile: true})
+		if err != nil {
+			t.Fatal(err)
+		} else if len(res.Profile) != 1 {
+			t.Fatalf("unexpected profile: %+v", res.Profile)
+		}
+		for shard, sp := range shardProfiles(res.Profile[0]) {
+			if sp.RankCacheHits == 0 {
+				t.Fatalf("expected shard %d to read the rank cache: %+v", shard, sp)
+			}
+		}
+	})
+}
+
 // Ensure SetColumnAttrs doesn't save `field` as an attribute
 func TestExecutor_SetColumnAttrs_ExcludeField(t *testing.T) {
 	c := test.MustRunCluster(t, 1)
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
@@ -39,17 +40,21 @@ const (
 
 	// Default ranked field cache
 	DefaultCacheSize = 50000
-
-	bitsPerWord = 32 << (^uint(0) >> 63) // either 32 or 64
-	maxInt      = 1<<(bitsPerWord-1) - 1 // either 1<<31 - 1 or 1<<63 - 1
-
 )
 
 // Field types.
 const (
-	FieldTypeSet  = "set"
-	FieldTypeInt  = "int"
-	FieldTypeTime = "time"
+	FieldTypeSet   = "set"
+	FieldTypeInt   = "int"
+	FieldTypeTime  = "time"
+	FieldTypeMutex = "mutex"
+	FieldTypeBool  = "bool"
+)
+
+// Row IDs used to store the values of a bool field.
+const (
+	falseRowID = uint64(0)
+	trueRowID  = uint64(1)
 )
 
 // Field represents a container for views.
@@ -70,6 +75,9 @@ type Field struct {
 	// Field options.
 	options FieldOptions
 
+	// Time zone in which time views are named.
+	location *time.Location
+
 	bsiGroups []*bsiGroup
 
 	Logger Logger
@@ -90,6 +98,28 @@ func OptFieldTypeSet(cacheType string, cacheSize uint32) FieldOption {
 	}
 }
 
+func OptFieldTypeMutex(cacheType string, cacheSize uint32) FieldOption {
+	return func(fo *FieldOptions) error {
+		if fo.Type != "" {
+			return errors.Errorf("field type is already set to: %s", fo.Type)
+		}
+		fo.Type = FieldTypeMutex
+		fo.CacheType = cacheType
+		fo.CacheSize = cacheSize
+		return nil
+	}
+}
+
+func OptFieldTypeBool() FieldOption {
+	return func(fo *FieldOptions) error {
+		if fo.Type != "" {
+			return errors.Errorf("field type is already set to: %s", fo.Type)
+		}
+		fo.Type = FieldTypeBool
+		return nil
+	}
+}
+
 func OptFieldTypeInt(min, max int64) FieldOption {
 	return func(fo *FieldOptions) error {
 		if fo.Type != "" {
@@ -105,6 +135,22 @@ func OptFieldTypeInt(min, max int64) FieldOption {
 	}
 }
 
+// OptFieldTypeDecimal is a functional option for creating an int field which
+// stores values with scale decimal places. Min and max are whole numbers.
+func OptFieldTypeDecimal(scale int64, min, max int64) FieldOption {
+	return func(fo *FieldOptions) error {
+		if err := OptFieldTypeInt(min, max)(fo); err != nil {
+			return err
+		}
+		if scale < 0 || scale > maxBSIGroupScale {
+			return ErrInvalidBSIGroupScale
+		}
+		fo.Scale = scale
+		return nil
+	}
+}
+
+// OptFieldTypeTime is a functional option for creating a time field.
 func OptFieldTypeTime(timeQuantum TimeQuantum) FieldOption {
 	return func(fo *FieldOptions) error {
 		if fo.Type != "" {
@@ -119,14 +165,94 @@ func OptFieldTypeTime(timeQuantum TimeQuantum) FieldOption {
 	}
 }
 
+// OptFieldTypeTimeZone is a functional option for creating a time field
+// whose views are named from times in the given IANA time zone, such as
+// "America/New_York", so that daily views split at local midnight.
+func OptFieldTypeTimeZone(timeQuantum TimeQuantum, timeZone string) FieldOption {
+	return func(fo *FieldOptions) error {
+		if err := OptFieldTypeTime(timeQuantum)(fo); err != nil {
+			return err
+		}
+		if _, err := loadTimeZone(timeZone); err != nil {
+			return err
+		}
+		fo.TimeZone = timeZone
+		return nil
+	}
+}
+
+// OptFieldNoStandardView is a functional option which makes a time field only
+// write bits to its time views, without a standard view. It must follow the
+// option setting the field type.
+func OptFieldNoStandardView() FieldOption {
+	return func(fo *FieldOptions) error {
+		if fo.Type != FieldTypeTime {
+			return errors.Errorf("no standard view does not apply to field type: %s", fo.Type)
+		}
+		fo.NoStandardView = true
+		return nil
+	}
+}
+
+// OptFieldTimeRetention is a functional option which sets how long the views
+// of a time field are kept. It must follow the option setting the field type.
+func OptFieldTimeRetention(retention TimeRetention) FieldOption {
+	return func(fo *FieldOptions) error {
+		if fo.Type != FieldTypeTime {
+			return errors.Errorf("time retention does not apply to field type: %s", fo.Type)
+		}
+		if _, err := ParseTimeRetention(string(retention)); err != nil {
+			return err
+		}
+		fo.TimeRetention = retention
+		return nil
+	}
+}
+
+// OptFieldTimeRollup is a functional option which sets when the views of a
+// time field are folded into larger units. It must follow the option setting
+// the field type.
+func OptFieldTimeRollup(rollup TimeRollup) FieldOption {
+	return func(fo *FieldOptions) error {
+		if fo.Type != FieldTypeTime {
+			return errors.Errorf("time rollup does not apply to field type: %s", fo.Type)
+		}
+		if err := validateTimeRollup(rollup, fo.TimeQuantum); err != nil {
+			return err
+		}
+		fo.TimeRollup = rollup
+		return nil
+	}
+}
+
+// validateTimeRollup returns an error if rollup cannot be parsed or folds a
+// unit which is not in q, or into a unit which is not in q.
+func validateTimeRollup(rollup TimeRollup, q TimeQuantum) error {
+	cutoffs, err := rollup.cutoffs(time.Time{})
+	if err != nil {
+		return err
+	}
+	for unit := range cutoffs {
+		parent, _ := parentTimeUnit(unit)
+		if !strings.ContainsRune(string(q), unit) || !strings.ContainsRune(string(q), parent) {
+			return errors.Wrapf(ErrInvalidTimeRollup, "%c views cannot be folded with time quantum %q", unit, q)
+		}
+	}
+	return nil
+}
+
 // NewField returns a new instance of field.
 func NewField(path, index, name string, options FieldOptions) (*Field, error) {
 	err := validateName(name)
 	if err != nil {
 		return nil, err
 	}
+	return newField(path, index, name, options), nil
+}
 
-	f := &Field{
+// newField returns a new instance of field without validating its name.
+func newField(path, index, name string, options FieldOptions) *Field {
+	return &Field{
 		path:  path,
 		index: index,
 		name:  name,
@@ -142,7 +268,6 @@ func NewField(path, index, name string, options FieldOptions) (*Field, error) {
 
 		Logger: NopLogger,
 	}
-	return f, nil
 }
 
 // Name returns the name the field was initialized with.
@@ -310,6 +435,11 @@ func (f *Field) loadMeta() error {
 	f.options.Max = pb.Max
 	f.options.TimeQuantum = TimeQuantum(pb.TimeQuantum)
 	f.options.Keys = pb.Keys
+	f.options.Scale = pb.Scale
+	f.options.TimeZone = pb.TimeZone
+	f.options.NoStandardView = pb.NoStandardView
+	f.options.TimeRetention = TimeRetention(pb.TimeRetention)
+	f.options.TimeRollup = TimeRollup(pb.TimeRollup)
 
 	return nil
 }
@@ -334,8 +464,11 @@ func (f *Field) saveMeta() error {
 // applyOptions configures the field based on opt.
 func (f *Field) applyOptions(opt FieldOptions) error {
 	switch opt.Type {
-	case FieldTypeSet, "":
-		f.options.Type = FieldTypeSet
+	case FieldTypeSet, FieldTypeMutex, "":
+		f.options.Type = opt.Type
+		if opt.Type == "" {
+			f.options.Type = FieldTypeSet
+		}
 		if opt.CacheType != "" {
 			f.options.CacheType = opt.CacheType
 		}
@@ -344,7 +477,12 @@ func (f *Field) applyOptions(opt FieldOptions) error {
 		}
 		f.options.Min = 0
 		f.options.Max = 0
+		f.options.Scale = 0
 		f.options.TimeQuantum = ""
+		f.options.TimeZone = ""
+		f.options.NoStandardView = false
+		f.options.TimeRetention = ""
+		f.options.TimeRollup = ""
 		f.options.Keys = opt.Keys
 	case FieldTypeInt:
 		f.options.Type = opt.Type
@@ -352,15 +490,29 @@ func (f *Field) applyOptions(opt FieldOptions) error {
 		f.options.CacheSize = 0
 		f.options.Min = opt.Min
 		f.options.Max = opt.Max
+		f.options.Scale = opt.Scale
 		f.options.TimeQuantum = ""
+		f.options.TimeZone = ""
+		f.options.NoStandardView = false
+		f.options.TimeRetention = ""
+		f.options.TimeRollup = ""
 		f.options.Keys = opt.Keys
 
-		// Create new bsiGroup.
+		// Create new bsiGroup. Min & max are given in the units of the
+		// field so they are scaled to the stored integer range.
 		bsig := &bsiGroup{
-			Name: f.name,
-			Type: bsiGroupTypeInt,
-			Min:  opt.Min,
-			Max:  opt.Max,
+			Name:  f.name,
+			Type:  bsiGroupTypeInt,
+			Scale: opt.Scale,
+		}
+		if opt.Scale < 0 || opt.Scale > maxBSIGroupScale {
+			return ErrInvalidBSIGroupScale
+		}
+		var err error
+		if bsig.Min, err = bsig.scaledValue(opt.Min); err != nil {
+			return errors.Wrap(err, "scaling min")
+		} else if bsig.Max, err = bsig.scaledValue(opt.Max); err != nil {
+			return errors.Wrap(err, "scaling max")
 		}
 		// Validate bsiGroup.
 		if err := bsig.validate(); err != nil {
@@ -375,12 +527,41 @@ func (f *Field) applyOptions(opt FieldOptions) error {
 		f.options.CacheSize = 0
 		f.options.Min = 0
 		f.options.Max = 0
+		f.options.Scale = 0
 		f.options.Keys = opt.Keys
+		loc, err := loadTimeZone(opt.TimeZone)
+		if err != nil {
+			return errors.Wrapf(err, "loading time zone %q", opt.TimeZone)
+		}
+		f.options.TimeZone = opt.TimeZone
+		f.options.NoStandardView = opt.NoStandardView
+		f.location = loc
+		if _, err := ParseTimeRetention(string(opt.TimeRetention)); err != nil {
+			return err
+		}
+		f.options.TimeRetention = opt.TimeRetention
+		if err := validateTimeRollup(opt.TimeRollup, opt.TimeQuantum); err != nil {
+			return err
+		}
+		f.options.TimeRollup = opt.TimeRollup
 		// Set the time quantum.
 		if err := f.SetTimeQuantum(opt.TimeQuantum); err != nil {
 			f.Close()
 			return errors.Wrap(err, "setting time quantum")
 		}
+	case FieldTypeBool:
+		f.options.Type = opt.Type
+		f.options.CacheType = CacheTypeNone
+		f.options.CacheSize = 0
+		f.options.Min = 0
+		f.options.Max = 0
+		f.options.Scale = 0
+		f.options.TimeQuantum = ""
+		f.options.TimeZone = ""
+		f.options.NoStandardView = false
+		f.options.TimeRetention = ""
+		f.options.TimeRollup = ""
+		f.options.Keys = false
 	default:
 		return errors.New("invalid field type")
 	}
@@ -514,6 +695,25 @@ func (f *Field) TimeQuantum() TimeQuantum {
 	return f.options.TimeQuantum
 }
 
+// noStandardView returns true if bits are only written to the field's time
+// views.
+func (f *Field) noStandardView() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.options.Type == FieldTypeTime && f.options.NoStandardView
+}
+
+// timeLocation returns the time zone in which the field's time views are
+// named.
+func (f *Field) timeLocation() *time.Location {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.location == nil {
+		return time.UTC
+	}
+	return f.location
+}
+
 // SetTimeQuantum sets the time quantum for the field.
 func (f *Field) SetTimeQuantum(q TimeQuantum) error {
 	f.mu.Lock()
@@ -561,6 +761,12 @@ func (f *Field) views() []*View {
 	return other
 }
 
+// timeRange returns the earliest start and latest end of the field's time
+// views. Returns false if the field has no time views.
+func (f *Field) timeRange() (start, end time.Time, ok bool) {
+	return timeSpanOfViews(ViewStandard, f.viewNames())
+}
+
 // viewNames returns a list of all views (as a string) in the field.
 func (f *Field) viewNames() []string {
 	f.mu.Lock()
@@ -627,6 +833,7 @@ func (f *Field) createViewIfNotExistsBase(name string) (*View, bool, error) {
 func (f *Field) newView(path, name string) *View {
 	view := NewView(path, f.index, f.name, name, f.options.CacheSize)
 	view.cacheType = f.options.CacheType
+	view.mutex = f.options.Type == FieldTypeMutex || f.options.Type == FieldTypeBool
 	view.Logger = f.Logger
 	view.RowAttrStore = f.rowAttrStore
 	view.stats = f.Stats.WithTags(fmt.Sprintf("view:%s", name))
@@ -636,6 +843,9 @@ func (f *Field) newView(path, name string) *View {
 
 // deleteView removes the view from the field.
 func (f *Field) deleteView(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	view := f.viewMap[name]
 	if view == nil {
 		return ErrInvalidView
@@ -658,7 +868,7 @@ func (f *Field) deleteView(name string) error {
 
 // Row returns a row of the standard view.
 func (f *Field) Row(rowID uint64) (*Row, error) {
-	if f.Type() != FieldTypeSet {
+	if t := f.Type(); t != FieldTypeSet && t != FieldTypeMutex && t != FieldTypeBool {
 		return nil, errors.Errorf("row method unsupported for field type: %s", f.Type())
 	}
 	view := f.view(ViewStandard)
@@ -679,21 +889,40 @@ func (f *Field) ViewRow(viewName string, rowID uint64) (*Row, error) {
 	return view.row(rowID), nil
 }
 
+// Bit returns true if the bit for a row & column is set in the standard view.
+func (f *Field) Bit(rowID, colID uint64) (bool, error) {
+	view := f.view(ViewStandard)
+	if view == nil {
+		return false, nil
+	}
+	return view.bit(rowID, colID)
+}
+
 // SetBit sets a bit on a view within the field.
 func (f *Field) SetBit(rowID, colID uint64, t *time.Time) (changed bool, err error) {
 	viewName := ViewStandard
 
-	// Retrieve view. Exit if it doesn't exist.
-	view, err := f.createViewIfNotExists(viewName)
-	if err != nil {
-		return changed, errors.Wrap(err, "creating view")
+	// Bool fields only use the true & false rows.
+	if f.Type() == FieldTypeBool && rowID > trueRowID {
+		return false, ErrInvalidBoolValue
 	}
 
-	// Set non-time bit.
-	if v, err := view.setBit(rowID, colID); err != nil {
-		return changed, errors.Wrap(err, "setting on view")
-	} else if v {
-		changed = v
+	// Set non-time bit, unless the field only has time views.
+	if f.noStandardView() {
+		if t == nil {
+			return false, ErrTimestampRequired
+		}
+	} else {
+		view, err := f.createViewIfNotExists(viewName)
+		if err != nil {
+			return changed, errors.Wrap(err, "creating view")
+		}
+
+		if v, err := view.setBit(rowID, colID); err != nil {
+			return changed, errors.Wrap(err, "setting on view")
+		} else if v {
+			changed = v
+		}
 	}
 
 	// Exit early if no timestamp is specified.
@@ -702,7 +931,7 @@ func (f *Field) SetBit(rowID, colID uint64, t *time.Time) (changed bool, err err
 	}
 
 	// If a timestamp is specified then set bits across all views for the quantum.
-	for _, subname := range viewsByTime(viewName, *t, f.TimeQuantum()) {
+	for _, subname := range viewsByTime(viewName, wallTime(*t, f.timeLocation()), f.TimeQuantum()) {
 		view, err := f.createViewIfNotExists(subname)
 		if err != nil {
 			return changed, errors.Wrapf(err, "creating view %s", subname)
@@ -722,86 +951,135 @@ func (f *Field) SetBit(rowID, colID uint64, t *time.Time) (changed bool, err err
 func (f *Field) ClearBit(rowID, colID uint64) (changed bool, err error) {
 	viewName := ViewStandard
 
-	// Retrieve view. Exit if it doesn't exist.
-	view, present := f.viewMap[viewName]
-	if !present {
-		return changed, errors.Wrap(err, "clearing missing view")
-
-	}
-
-	// Clear non-time bit.
-	if v, err := view.clearBit(rowID, colID); err != nil {
-		return changed, errors.Wrap(err, "clearing on view")
-	} else if v {
-		changed = v
+	// Clear non-time bit. The standard view does not exist if nothing has
+	// been set or if the field only has time views.
+	if view := f.view(viewName); view != nil {
+		if v, err := view.clearBit(rowID, colID); err != nil {
+			return changed, errors.Wrap(err, "clearing on view")
+		} else if v {
+			changed = v
+		}
 	}
-	if len(f.viewMap) == 1 { // assuming no time views
+	if f.TimeQuantum() == "" {
 		return changed, nil
 	}
-	lastViewNameSize := 0
-	level := 0
-	skipAbove := maxInt
-	for _, view := range f.allTimeViewsSortedByQuantum() {
-		if lastViewNameSize < len(view.name) {
-			level++
-		} else if lastViewNameSize > len(view.name) {
-			level--
-		}
-		if level < skipAbove {
-			if changed, err = view.clearBit(rowID, colID); err != nil {
-				return changed, errors.Wrapf(err, "clearing on view %s", view.name)
-			}
-			if !changed {
-				skipAbove = level + 1
-			} else {
-				skipAbove = maxInt
-			}
+	// Views are visited from the largest unit down, so a view which does not
+	// hold the bit lets the smaller views within its time range be skipped.
+	var skipUntil time.Time
+	for _, tv := range f.timeViews() {
+		if tv.start.Before(skipUntil) {
+			continue
+		}
+		v, err := tv.view.clearBit(rowID, colID)
+		if err != nil {
+			return changed, errors.Wrapf(err, "clearing on view %s", tv.view.name)
+		} else if v {
+			changed = true
+		} else {
+			skipUntil = tv.end
 		}
-		lastViewNameSize = len(view.name)
 	}
 
 	return changed, nil
 }
 
-func groupCompare(a, b string, offset int) (lt, eq bool) {
-	if len(a) > offset {
-		a = a[:offset]
-	}
-	if len(b) > offset {
-		b = b[:offset]
-	}
-	v := strings.Compare(a, b)
-	return v < 0, v == 0
-}
-
-func (f *Field) allTimeViewsSortedByQuantum() (me []*View) {
-	me = make([]*View, len(f.viewMap), len(f.viewMap))
-	prefix := ViewStandard + "_"
-	offset := len(ViewStandard) + 1
-	i := 0
-	for _, v := range f.viewMap {
-		if len(v.name) > offset && strings.Compare(v.name[:offset], prefix) == 0 { // skip non-time views
-			me[i] = v
-			i++
-		}
-	}
-	me = me[:i]
-	year := strings.Index(me[0].name, "_") + 4
-	month := year + 2
-	day := month + 2
-	sort.Slice(me, func(i, j int) (lt bool) {
-		var eq bool
-		// group by quantum from year to hour
-		if lt, eq = groupCompare(me[i].name, me[j].name, year); eq {
-			if lt, eq = groupCompare(me[i].name, me[j].name, month); eq {
-				if lt, eq = groupCompare(me[i].name, me[j].name, day); eq {
-					lt = strings.Compare(me[i].name, me[j].name) > 0
-				}
-			}
+// SetRow replaces a row of a set field within a single shard with the
+// columns of row in that shard. Columns of row in other shards are ignored.
+func (f *Field) SetRow(shard, rowID uint64, row *Row) (changed bool, err error) {
+	if f.Type() != FieldTypeSet {
+		return false, fmt.Errorf("cannot replace rows of %s field", f.Type())
+	}
+
+	// Skip creating the view if there is nothing to store.
+	view := f.view(ViewStandard)
+	if view == nil {
+		if row.segment(shard) == nil {
+			return false, nil
+		}
+		if view, err = f.createViewIfNotExists(ViewStandard); err != nil {
+			return false, errors.Wrap(err, "creating view")
+		}
+	}
+	return view.setRow(shard, rowID, row)
+}
+
+// timeView is a time view of a field and the time range it covers.
+type timeView struct {
+	view       *View
+	start, end time.Time
+}
+
+// timeViews returns the field's time views ordered by start time, with each
+// view before the views of smaller units within its range.
+func (f *Field) timeViews() []timeView {
+	var a []timeView
+	for _, view := range f.views() {
+		if start, end, ok := timeOfView(ViewStandard, view.name); ok {
+			a = append(a, timeView{view: view, start: start, end: end})
+		}
+	}
+	sort.Slice(a, func(i, j int) bool {
+		if !a[i].start.Equal(a[j].start) {
+			return a[i].start.Before(a[j].start)
 		}
-		return
+		return a[i].end.After(a[j].end)
 	})
-	return
+	return a
+}
+
+// expiredViews returns the names of the time views which are older than the
+// field's time retention at now. A view expires once its whole time range is
+// before the cutoff of its unit.
+func (f *Field) expiredViews(now time.Time) []string {
+	cutoffs, err := f.Options().TimeRetention.cutoffs(wallTime(now, f.timeLocation()))
+	if err != nil || len(cutoffs) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, tv := range f.timeViews() {
+		cutoff, ok := cutoffs[timeUnitOfView(ViewStandard, tv.view.name)]
+		if ok && !tv.end.After(cutoff) {
+			names = append(names, tv.view.name)
+		}
+	}
+	return names
+}
+
+// viewsByTimeRange returns the time views to read for a range of wall times.
+// Views which the field's time rollup has folded at now are replaced by the
+// larger views they were folded into, which may cover more than the range.
+func (f *Field) viewsByTimeRange(start, end, now time.Time) []string {
+	names := viewsByTimeRange(ViewStandard, start, end, f.TimeQuantum())
+	cutoffs, err := f.Options().TimeRollup.cutoffs(wallTime(now, f.timeLocation()))
+	if err != nil {
+		return names
+	}
+	return rollupViews(ViewStandard, names, cutoffs)
+}
+
+// compactViews deletes the time views which are older than the field's time
+// rollup at now. The time quantum must include the next larger unit of each
+// rolled up unit, so every bit of a deleted view is already set in the view
+// of that unit, which is read in its place.
+func (f *Field) compactViews(now time.Time) ([]string, error) {
+	cutoffs, err := f.Options().TimeRollup.cutoffs(wallTime(now, f.timeLocation()))
+	if err != nil || len(cutoffs) == 0 {
+		return nil, err
+	}
+
+	var names []string
+	for _, tv := range f.timeViews() {
+		cutoff, ok := cutoffs[timeUnitOfView(ViewStandard, tv.view.name)]
+		if !ok || tv.end.After(cutoff) {
+			continue
+		}
+		if err := f.deleteView(tv.view.name); err != nil {
+			return names, errors.Wrap(err, "deleting view")
+		}
+		names = append(names, tv.view.name)
+	}
+	return names, nil
 }
 
 // Value reads a field value for a column.
@@ -850,6 +1128,71 @@ func (f *Field) SetValue(columnID uint64, value int64) (changed bool, err error)
 	return view.setValue(columnID, bsig.BitDepth(), baseValue)
 }
 
+// SetValueIf sets a field value for a column only if the column currently
+// holds the expected value, or holds no value if expected is nil. Returns
+// true if the value was set.
+func (f *Field) SetValueIf(columnID uint64, value int64, expected *int64) (applied bool, err error) {
+	// Fetch bsiGroup and validate value.
+	bsig := f.bsiGroup(f.name)
+	if bsig == nil {
+		return false, ErrBSIGroupNotFound
+	} else if value < bsig.Min {
+		return false, ErrBSIGroupValueTooLow
+	} else if value > bsig.Max {
+		return false, ErrBSIGroupValueTooHigh
+	}
+
+	// A value outside of the field's range can never be held by a column.
+	var expectedBase *uint64
+	if expected != nil {
+		if *expected < bsig.Min || *expected > bsig.Max {
+			return false, nil
+		}
+		v := uint64(*expected - bsig.Min)
+		expectedBase = &v
+	}
+
+	view, err := f.createViewIfNotExists(viewBSIGroupPrefix + f.name)
+	if err != nil {
+		return false, errors.Wrap(err, "creating view")
+	}
+	return view.setValueIf(columnID, bsig.BitDepth(), uint64(value-bsig.Min), expectedBase)
+}
+
+// IncrementValue adds delta to the field value for a column and returns the
+// new value. A column without a value is incremented from zero.
+func (f *Field) IncrementValue(columnID uint64, delta int64) (value int64, err error) {
+	bsig := f.bsiGroup(f.name)
+	if bsig == nil {
+		return 0, ErrBSIGroupNotFound
+	}
+
+	view, err := f.createViewIfNotExists(viewBSIGroupPrefix + f.name)
+	if err != nil {
+		return 0, errors.Wrap(err, "creating view")
+	}
+
+	baseValue, err := view.incrementValue(columnID, bsig.BitDepth(), delta, -bsig.Min, uint64(bsig.Max-bsig.Min))
+	if err != nil {
+		return 0, err
+	}
+	return int64(baseValue) + bsig.Min, nil
+}
+
+// ClearValue removes the field value for a column.
+func (f *Field) ClearValue(columnID uint64) (changed bool, err error) {
+	bsig := f.bsiGroup(f.name)
+	if bsig == nil {
+		return false, ErrBSIGroupNotFound
+	}
+
+	view := f.view(viewBSIGroupPrefix + f.name)
+	if view == nil {
+		return false, nil
+	}
+	return view.clearValue(columnID, bsig.BitDepth())
+}
+
 // Sum returns the sum and count for a field.
 // An optional filtering row can be provided.
 func (f *Field) Sum(filter *Row, name string) (sum, count int64, err error) {
@@ -964,7 +1307,18 @@ func (f *Field) Import(rowIDs, columnIDs []uint64, timestamps []*time.Time) erro
 		return errors.New("time quantum not set in field")
 	}
 
+	// Bool fields only use the true & false rows.
+	if f.Type() == FieldTypeBool {
+		for _, rowID := range rowIDs {
+			if rowID > trueRowID {
+				return ErrInvalidBoolValue
+			}
+		}
+	}
+
 	// Split import data by fragment.
+	noStandardView := f.noStandardView()
+	loc := f.timeLocation()
 	dataByFragment := make(map[importKey]importData)
 	for i := range rowIDs {
 		rowID, columnID := rowIDs[i], columnIDs[i]
@@ -975,12 +1329,17 @@ func (f *Field) Import(rowIDs, columnIDs []uint64, timestamps []*time.Time) erro
 
 		var standard []string
 		if timestamp == nil {
+			if noStandardView {
+				return ErrTimestampRequired
+			}
 			standard = []string{ViewStandard}
 		} else {
-			standard = viewsByTime(ViewStandard, *timestamp, q)
+			standard = viewsByTime(ViewStandard, wallTime(*timestamp, loc), q)
 			// In order to match the logic of `SetBit()`, we want bits
 			// with timestamps to write to both time and standard views.
-			standard = append(standard, ViewStandard)
+			if !noStandardView {
+				standard = append(standard, ViewStandard)
+			}
 		}
 
 		// Attach bit to each standard view.
@@ -1013,8 +1372,52 @@ func (f *Field) Import(rowIDs, columnIDs []uint64, timestamps []*time.Time) erro
 	return nil
 }
 
-// ImportValue bulk imports range-encoded value data.
-func (f *Field) ImportValue(columnIDs []uint64, values []int64) error {
+// ImportOptions holds the options for a bulk import.
+type ImportOptions struct {
+	// Clear removes the imported data instead of setting it.
+	Clear bool
+
+	// Increment adds imported values to the existing values of an int
+	// field instead of replacing them.
+	Increment bool
+}
+
+// ImportOption is a functional option type for bulk imports.
+type ImportOption func(*ImportOptions) error
+
+// OptImportOptionsClear is a functional option for removing the imported
+// data instead of setting it.
+func OptImportOptionsClear(clear bool) ImportOption {
+	return func(o *ImportOptions) error {
+		o.Clear = clear
+		return nil
+	}
+}
+
+// OptImportOptionsIncrement is a functional option for adding imported values
+// to the existing values of an int field.
+func OptImportOptionsIncrement(increment bool) ImportOption {
+	return func(o *ImportOptions) error {
+		o.Increment = increment
+		return nil
+	}
+}
+
+// ImportValue bulk imports range-encoded value data. Values are in the units
+// of the field and are scaled if the field has a scale. When the Clear option
+// is set, the values of the columns are removed and values may be nil. When
+// the Increment option is set, values are deltas added to the existing values.
+func (f *Field) ImportValue(columnIDs []uint64, values []int64, opts ...ImportOption) error {
+	var options ImportOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return errors.Wrap(err, "applying option")
+		}
+	}
+	if options.Clear && options.Increment {
+		return errors.New("clear and increment import options are mutually exclusive")
+	}
+
 	viewName := viewBSIGroupPrefix + f.name
 	// Get the bsiGroup so we know bitDepth.
 	bsig := f.bsiGroup(f.name)
@@ -1025,11 +1428,26 @@ func (f *Field) ImportValue(columnIDs []uint64, values []int64) error {
 	// Split import data by fragment.
 	dataByFragment := make(map[importKey]importValueData)
 	for i := range columnIDs {
-		columnID, value := columnIDs[i], values[i]
-		if int64(value) > bsig.Max {
-			return fmt.Errorf("%v, columnID=%v, value=%v", ErrBSIGroupValueTooHigh, columnID, value)
-		} else if int64(value) < bsig.Min {
-			return fmt.Errorf("%v, columnID=%v, value=%v", ErrBSIGroupValueTooLow, columnID, value)
+		columnID := columnIDs[i]
+
+		// Imported values are whole numbers in the units of the field, so
+		// they are scaled to the stored integer as in SetValue().
+		var value int64
+		if !options.Clear {
+			v, err := bsig.scaledValue(values[i])
+			if err != nil {
+				return fmt.Errorf("%v, columnID=%v, value=%v", err, columnID, values[i])
+			}
+			value = v
+		}
+
+		// Deltas are checked against the bounds once they are applied.
+		if !options.Clear && !options.Increment {
+			if int64(value) > bsig.Max {
+				return fmt.Errorf("%v, columnID=%v, value=%v", ErrBSIGroupValueTooHigh, columnID, values[i])
+			} else if int64(value) < bsig.Min {
+				return fmt.Errorf("%v, columnID=%v, value=%v", ErrBSIGroupValueTooLow, columnID, values[i])
+			}
 		}
 
 		// Attach value to each bsiGroup view.
@@ -1057,12 +1475,19 @@ func (f *Field) ImportValue(columnIDs []uint64, values []int64) error {
 			return errors.Wrap(err, "creating fragment")
 		}
 
+		if options.Increment {
+			if err := frag.importIncrementValue(data.ColumnIDs, data.Values, bsig.BitDepth(), -bsig.Min, uint64(bsig.Max-bsig.Min)); err != nil {
+				return err
+			}
+			continue
+		}
+
 		baseValues := make([]uint64, len(data.Values))
 		for i, value := range data.Values {
 			baseValues[i] = uint64(value - bsig.Min)
 		}
 
-		if err := frag.importValue(data.ColumnIDs, baseValues, bsig.BitDepth()); err != nil {
+		if err := frag.importValue(data.ColumnIDs, baseValues, bsig.BitDepth(), options.Clear); err != nil {
 			return err
 		}
 	}
@@ -1110,13 +1535,18 @@ func (p fieldInfoSlice) Less(i, j int) bool { return p[i].Name < p[j].Name }
 
 // FieldOptions represents options to set when initializing a field.
 type FieldOptions struct {
-	Type        string      `json:"type,omitempty"`
-	CacheType   string      `json:"cacheType,omitempty"`
-	CacheSize   uint32      `json:"cacheSize,omitempty"`
-	Min         int64       `json:"min,omitempty"`
-	Max         int64       `json:"max,omitempty"`
-	TimeQuantum TimeQuantum `json:"timeQuantum,omitempty"`
-	Keys        bool        `json:"keys,omitempty"`
+	Type           string        `json:"type,omitempty"`
+	CacheType      string        `json:"cacheType,omitempty"`
+	CacheSize      uint32        `json:"cacheSize,omitempty"`
+	Min            int64         `json:"min,omitempty"`
+	Max            int64         `json:"max,omitempty"`
+	Scale          int64         `json:"scale,omitempty"`
+	TimeQuantum    TimeQuantum   `json:"timeQuantum,omitempty"`
+	TimeZone       string        `json:"timeZone,omitempty"`
+	NoStandardView bool          `json:"noStandardView,omitempty"`
+	TimeRetention  TimeRetention `json:"timeRetention,omitempty"`
+	TimeRollup     TimeRollup    `json:"timeRollup,omitempty"`
+	Keys           bool          `json:"keys,omitempty"`
 }
 
 // applyDefaultOptions returns a new FieldOptions object
@@ -1142,13 +1572,18 @@ func encodeFieldOptions(o *FieldOptions) *internal.FieldOptions {
 		return nil
 	}
 	return &internal.FieldOptions{
-		Type:        o.Type,
-		CacheType:   o.CacheType,
-		CacheSize:   o.CacheSize,
-		Min:         o.Min,
-		Max:         o.Max,
-		TimeQuantum: string(o.TimeQuantum),
-		Keys:        o.Keys,
+		Type:           o.Type,
+		CacheType:      o.CacheType,
+		CacheSize:      o.CacheSize,
+		Min:            o.Min,
+		Max:            o.Max,
+		TimeQuantum:    string(o.TimeQuantum),
+		Keys:           o.Keys,
+		Scale:          o.Scale,
+		TimeZone:       o.TimeZone,
+		NoStandardView: o.NoStandardView,
+		TimeRetention:  string(o.TimeRetention),
+		TimeRollup:     string(o.TimeRollup),
 	}
 }
 
@@ -1157,19 +1592,24 @@ func decodeFieldOptions(options *internal.FieldOptions) *FieldOptions {
 		return nil
 	}
 	return &FieldOptions{
-		Type:        options.Type,
-		CacheType:   options.CacheType,
-		CacheSize:   options.CacheSize,
-		Min:         options.Min,
-		Max:         options.Max,
-		TimeQuantum: TimeQuantum(options.TimeQuantum),
-		Keys:        options.Keys,
+		Type:           options.Type,
+		CacheType:      options.CacheType,
+		CacheSize:      options.CacheSize,
+		Min:            options.Min,
+		Max:            options.Max,
+		TimeQuantum:    TimeQuantum(options.TimeQuantum),
+		Keys:           options.Keys,
+		Scale:          options.Scale,
+		TimeZone:       options.TimeZone,
+		NoStandardView: options.NoStandardView,
+		TimeRetention:  TimeRetention(options.TimeRetention),
+		TimeRollup:     TimeRollup(options.TimeRollup),
 	}
 }
 
 func (o *FieldOptions) MarshalJSON() ([]byte, error) {
 	switch o.Type {
-	case FieldTypeSet:
+	case FieldTypeSet, FieldTypeMutex:
 		return json.Marshal(struct {
 			Type      string `json:"type"`
 			CacheType string `json:"cacheType"`
@@ -1181,21 +1621,37 @@ func (o *FieldOptions) MarshalJSON() ([]byte, error) {
 		})
 	case FieldTypeInt:
 		return json.Marshal(struct {
-			Type string `json:"type"`
-			Min  int64  `json:"min"`
-			Max  int64  `json:"max"`
+			Type  string `json:"type"`
+			Min   int64  `json:"min"`
+			Max   int64  `json:"max"`
+			Scale int64  `json:"scale,omitempty"`
 		}{
 			o.Type,
 			o.Min,
 			o.Max,
+			o.Scale,
 		})
 	case FieldTypeTime:
 		return json.Marshal(struct {
-			Type        string      `json:"type"`
-			TimeQuantum TimeQuantum `json:"timeQuantum"`
+			Type           string        `json:"type"`
+			TimeQuantum    TimeQuantum   `json:"timeQuantum"`
+			TimeZone       string        `json:"timeZone,omitempty"`
+			NoStandardView bool          `json:"noStandardView,omitempty"`
+			TimeRetention  TimeRetention `json:"timeRetention,omitempty"`
+			TimeRollup     TimeRollup    `json:"timeRollup,omitempty"`
 		}{
 			o.Type,
 			o.TimeQuantum,
+			o.TimeZone,
+			o.NoStandardView,
+			o.TimeRetention,
+			o.TimeRollup,
+		})
+	case FieldTypeBool:
+		return json.Marshal(struct {
+			Type string `json:"type"`
+		}{
+			o.Type,
 		})
 	}
 	return nil, errors.New("invalid field type")
@@ -1206,6 +1662,10 @@ const (
 	bsiGroupTypeInt = "int"
 )
 
+// maxBSIGroupScale is the largest number of decimal places a bsiGroup can
+// store. Larger scales cannot represent a whole unit in an int64.
+const maxBSIGroupScale = 18
+
 func isValidBSIGroupType(v string) bool {
 	switch v {
 	case bsiGroupTypeInt:
@@ -1217,10 +1677,11 @@ func isValidBSIGroupType(v string) bool {
 
 // bsiGroup represents a group of range-encoded rows on a field.
 type bsiGroup struct {
-	Name string `json:"name,omitempty"`
-	Type string `json:"type,omitempty"`
-	Min  int64  `json:"min,omitempty"`
-	Max  int64  `json:"max,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Min   int64  `json:"min,omitempty"`
+	Max   int64  `json:"max,omitempty"`
+	Scale int64  `json:"scale,omitempty"`
 }
 
 // BitDepth returns the number of bits required to store a value between min & max.
@@ -1269,6 +1730,55 @@ func (b *bsiGroup) baseValue(op pql.Token, value int64) (baseValue uint64, outOf
 	return baseValue, false
 }
 
+// scaledValue converts a value in the units of the field into the integer
+// stored in the bsiGroup. Values may be fractional up to the number of
+// decimal places allowed by the bsiGroup's scale.
+func (b *bsiGroup) scaledValue(v interface{}) (int64, error) {
+	multiplier := b.multiplier()
+
+	switch v := v.(type) {
+	case int64:
+		if v > math.MaxInt64/multiplier {
+			return 0, ErrBSIGroupValueTooHigh
+		} else if v < math.MinInt64/multiplier {
+			return 0, ErrBSIGroupValueTooLow
+		}
+		return v * multiplier, nil
+	case float64:
+		scaled := v * float64(multiplier)
+		if scaled >= math.MaxInt64 {
+			return 0, ErrBSIGroupValueTooHigh
+		} else if scaled <= math.MinInt64 {
+			return 0, ErrBSIGroupValueTooLow
+		}
+
+		// Allow for floating point error but reject values with more
+		// decimal places than the scale.
+		rounded := math.Round(scaled)
+		if math.Abs(scaled-rounded) > 1e-6+math.Abs(scaled)*1e-15 {
+			return 0, ErrInvalidBSIGroupValueScale
+		}
+		return int64(rounded), nil
+	default:
+		return 0, ErrInvalidBSIGroupValueType
+	}
+}
+
+// multiplier returns the stored integer which represents one unit of the field.
+func (b *bsiGroup) multiplier() int64 {
+	return int64(math.Pow10(int(b.Scale)))
+}
+
+// unscaledValue converts an integer stored in the bsiGroup into the units of
+// the field. Values are returned as int64 if the bsiGroup has no scale and as
+// float64 otherwise.
+func (b *bsiGroup) unscaledValue(v int64) interface{} {
+	if b.Scale == 0 {
+		return v
+	}
+	return float64(v) / math.Pow10(int(b.Scale))
+}
+
 // baseValueBetween adjusts the min/max value to align with the range for Field.
 func (b *bsiGroup) baseValueBetween(min, max int64) (baseValueMin, baseValueMax uint64, outOfRange bool) {
 	if max < b.Min || min > b.Max {
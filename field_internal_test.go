@@ -16,11 +16,15 @@ package pilosa
 
 import (
 	"io/ioutil"
+	"math"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/pilosa/pilosa/pql"
+	"github.com/pkg/errors"
 )
 
 // Ensure a bsiGroup can adjust to its baseValue.
@@ -261,6 +265,207 @@ func TestField_CreateViewIfNotExists(t *testing.T) {
 	}
 }
 
+// Ensure a bsiGroup can convert values to and from its scale.
+func TestBSIGroup_ScaledValue(t *testing.T) {
+	b := &bsiGroup{Name: "b", Type: bsiGroupTypeInt, Scale: 2}
+
+	for _, tt := range []struct {
+		val interface{}
+		exp int64
+		err error
+	}{
+		{int64(10), 1000, nil},
+		{int64(-3), -300, nil},
+		{1.5, 150, nil},
+		{-0.07, -7, nil},
+		{10.29, 1029, nil},
+		{1.234, 0, ErrInvalidBSIGroupValueScale},
+		{int64(math.MaxInt64 / 10), 0, ErrBSIGroupValueTooHigh},
+		{"a", 0, ErrInvalidBSIGroupValueType},
+	} {
+		if v, err := b.scaledValue(tt.val); err != tt.err {
+			t.Fatalf("scaledValue(%v): unexpected error: %v", tt.val, err)
+		} else if v != tt.exp {
+			t.Fatalf("scaledValue(%v): expected %d, got %d", tt.val, tt.exp, v)
+		}
+	}
+
+	if v := b.unscaledValue(1029); v != 10.29 {
+		t.Fatalf("unexpected unscaled value: %v", v)
+	} else if v := (&bsiGroup{}).unscaledValue(1029); v != int64(1029) {
+		t.Fatalf("unexpected unscaled value without scale: %v", v)
+	}
+}
+
+// Ensure a field's scale is persisted in its meta data.
+func TestField_Scale(t *testing.T) {
+	f := MustOpenField(FieldOptions{Type: FieldTypeInt, Min: -10, Max: 100, Scale: 3})
+	defer f.Close()
+
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if opt := f.Options(); opt.Scale != 3 || opt.Min != -10 || opt.Max != 100 {
+		t.Fatalf("unexpected options (reopen): %#v", opt)
+	} else if bsig := f.bsiGroup("f"); bsig.Scale != 3 || bsig.Min != -10000 || bsig.Max != 100000 {
+		t.Fatalf("unexpected bsiGroup (reopen): %#v", bsig)
+	}
+}
+
+// Ensure time views of a field with a time zone are named in local time.
+func TestField_TimeZone(t *testing.T) {
+	f := MustOpenField(FieldOptions{Type: FieldTypeTime, TimeQuantum: "YMD", TimeZone: "America/New_York"})
+	defer f.Close()
+
+	// 03:00 UTC is the previous evening in New York.
+	ts := time.Date(2000, 1, 1, 3, 0, 0, 0, time.UTC)
+	if _, err := f.SetBit(1, 1, &ts); err != nil {
+		t.Fatal(err)
+	} else if err := f.Import([]uint64{2}, []uint64{2}, []*time.Time{&ts}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"standard_1999", "standard_199912", "standard_19991231"} {
+		if v := f.view(name); v == nil {
+			t.Fatalf("expected view %s", name)
+		} else if n := v.row(1).Count() + v.row(2).Count(); n != 2 {
+			t.Fatalf("unexpected count in view %s: %d", name, n)
+		}
+	}
+	if v := f.view("standard_20000101"); v != nil {
+		t.Fatal("unexpected UTC view")
+	}
+
+	// Reload field and verify that the time zone is persisted.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if opt := f.Options(); opt.TimeZone != "America/New_York" {
+		t.Fatalf("unexpected time zone (reopen): %s", opt.TimeZone)
+	} else if loc := f.timeLocation(); loc.String() != "America/New_York" {
+		t.Fatalf("unexpected location (reopen): %s", loc)
+	}
+}
+
+// Ensure a time field without a standard view only writes to its time views.
+func TestField_NoStandardView(t *testing.T) {
+	f := MustOpenField(FieldOptions{Type: FieldTypeTime, TimeQuantum: "YMD", NoStandardView: true})
+	defer f.Close()
+
+	ts := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := f.SetBit(1, 1, &ts); err != nil {
+		t.Fatal(err)
+	} else if err := f.Import([]uint64{1}, []uint64{2}, []*time.Time{&ts}); err != nil {
+		t.Fatal(err)
+	} else if v := f.view(ViewStandard); v != nil {
+		t.Fatal("unexpected standard view")
+	} else if n := f.view("standard_20000102").row(1).Count(); n != 2 {
+		t.Fatalf("unexpected count: %d", n)
+	}
+
+	// Bits without a timestamp have nowhere to go.
+	if _, err := f.SetBit(1, 3, nil); err != ErrTimestampRequired {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := f.Import([]uint64{1}, []uint64{3}, nil); err != ErrTimestampRequired {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+
+	// Clearing a bit clears it from every time view.
+	if changed, err := f.ClearBit(1, 1); err != nil {
+		t.Fatal(err)
+	} else if !changed {
+		t.Fatal("expected bit to be cleared")
+	}
+	for _, name := range []string{"standard_2000", "standard_200001", "standard_20000102"} {
+		if columns := f.view(name).row(1).Columns(); !reflect.DeepEqual(columns, []uint64{2}) {
+			t.Fatalf("unexpected columns in view %s: %v", name, columns)
+		}
+	}
+
+	// Reload field and verify that the option is persisted.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if !f.Options().NoStandardView || !f.noStandardView() {
+		t.Fatal("expected no standard view (reopen)")
+	}
+}
+
+func TestField_ExpiredViews(t *testing.T) {
+	f := MustOpenField(FieldOptions{Type: FieldTypeTime, TimeQuantum: "YMDH", TimeRetention: "H=2d,D=1M"})
+	defer f.Close()
+
+	for i, v := range []string{"2000-01-01 05:00", "2000-04-13 11:00", "2000-04-14 10:00"} {
+		ts := mustParseTime(v)
+		if _, err := f.SetBit(1, uint64(i), &ts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only hourly and daily views are expired, and only once they have
+	// ended before the cutoff.
+	names := f.expiredViews(mustParseTime("2000-04-15 12:30"))
+	sort.Strings(names)
+	if exp := []string{"standard_20000101", "standard_2000010105", "standard_2000041311"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("unexpected expired views: %v", names)
+	}
+
+	// Reload field and verify that the option is persisted.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if r := f.Options().TimeRetention; r != "H=2d,D=1M" {
+		t.Fatalf("unexpected time retention (reopen): %q", r)
+	}
+}
+
+func TestField_CompactViews(t *testing.T) {
+	f := MustOpenField(FieldOptions{Type: FieldTypeTime, TimeQuantum: "YMDH", TimeRollup: "H=2d,D=1M"})
+	defer f.Close()
+
+	for i, v := range []string{"2000-01-01 05:00", "2000-04-13 11:00", "2000-04-14 10:00"} {
+		ts := mustParseTime(v)
+		if _, err := f.SetBit(1, uint64(i), &ts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Hours are dropped in favour of days, and days in favour of months.
+	names, err := f.compactViews(mustParseTime("2000-04-15 12:30"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	if exp := []string{"standard_20000101", "standard_2000010105", "standard_2000041311"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("unexpected compacted views: %v", names)
+	}
+	for _, name := range names {
+		if f.view(name) != nil {
+			t.Fatalf("expected view %s to be deleted", name)
+		}
+	}
+	if columns := f.view("standard_200001").row(1).Columns(); !reflect.DeepEqual(columns, []uint64{0}) {
+		t.Fatalf("unexpected monthly columns: %v", columns)
+	} else if columns := f.view("standard_20000413").row(1).Columns(); !reflect.DeepEqual(columns, []uint64{1}) {
+		t.Fatalf("unexpected daily columns: %v", columns)
+	}
+
+	// A folded hour is read from its day.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if r := f.Options().TimeRollup; r != "H=2d,D=1M" {
+		t.Fatalf("unexpected time rollup (reopen): %q", r)
+	} else if views := f.viewsByTimeRange(mustParseTime("2000-04-13 11:00"), mustParseTime("2000-04-13 12:00"), mustParseTime("2000-04-15 12:30")); !reflect.DeepEqual(views, []string{"standard_20000413"}) {
+		t.Fatalf("unexpected views: %v", views)
+	}
+}
+
+func TestField_TimeRollupQuantum(t *testing.T) {
+	fo := FieldOptions{}
+	if err := OptFieldTypeTime("YMD")(&fo); err != nil {
+		t.Fatal(err)
+	} else if err := OptFieldTimeRollup("H=1d")(&fo); errors.Cause(err) != ErrInvalidTimeRollup {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := OptFieldTimeRollup("D=30d")(&fo); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestField_SetTimeQuantum(t *testing.T) {
 	f := MustOpenField(FieldOptions{Type: FieldTypeTime})
 	defer f.Close()
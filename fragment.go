@@ -80,6 +80,9 @@ type fragment struct {
 	storageData []byte
 	opN         int // number of ops since snapshot
 
+	// If set, only a single row may be set for each column.
+	mutex bool // passed in by field
+
 	// Cache for row counts.
 	CacheType string // passed in by field
 	cache     cache
@@ -327,6 +330,16 @@ func (f *fragment) row(rowID uint64) *Row {
 	return f.unprotectedRow(rowID, true, true)
 }
 
+// cachedRow returns a row by ID, like row, and whether the row cache served it.
+func (f *fragment) cachedRow(rowID uint64) (*Row, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r, ok := f.rowCache.Fetch(rowID); ok && r != nil {
+		return r, true
+	}
+	return f.unprotectedRow(rowID, false, true), false
+}
+
 func (f *fragment) unprotectedRow(rowID uint64, checkRowCache bool, updateRowCache bool) *Row {
 	if checkRowCache {
 		r, ok := f.rowCache.Fetch(rowID)
@@ -363,7 +376,21 @@ func (f *fragment) unprotectedRow(rowID uint64, checkRowCache bool, updateRowCac
 func (f *fragment) setBit(rowID, columnID uint64) (changed bool, err error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	return f.unprotectedSetBit(rowID, columnID)
+
+	// Clear any existing row for the column under the same lock so that
+	// concurrent writers cannot leave a mutex column in multiple rows.
+	if f.mutex {
+		if changed, err = f.unprotectedClearMutexColumn(rowID, columnID); err != nil {
+			return false, errors.Wrap(err, "clearing mutex column")
+		}
+	}
+
+	if v, err := f.unprotectedSetBit(rowID, columnID); err != nil {
+		return false, err
+	} else if v {
+		changed = true
+	}
+	return changed, nil
 }
 
 func (f *fragment) unprotectedSetBit(rowID, columnID uint64) (changed bool, err error) {
@@ -456,6 +483,84 @@ func (f *fragment) unprotectedClearBit(rowID, columnID uint64) (changed bool, er
 	return changed, nil
 }
 
+// unprotectedClearMutexColumn clears the bit for a column in every row other
+// than rowID.
+func (f *fragment) unprotectedClearMutexColumn(rowID, columnID uint64) (changed bool, err error) {
+	for _, existingID := range f.unprotectedColumnRows(columnID) {
+		if existingID == rowID {
+			continue
+		}
+		if v, err := f.unprotectedClearBit(existingID, columnID); err != nil {
+			return changed, err
+		} else if v {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// containerN returns the number of containers stored for the rows from start
+// up to end.
+func (f *fragment) containerN(start, end uint64) uint64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	// Number of containers that make up a single row.
+	const containersPerRow = ShardWidth >> 16
+
+	var n uint64
+	itr, _ := f.storage.Containers.Iterator(start * containersPerRow)
+	for itr.Next() {
+		if k, _ := itr.Value(); k >= end*containersPerRow {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// unprotectedColumnRows returns the IDs of the rows which have a column set.
+// Rather than walking every container, only the container holding the column
+// is probed in each row.
+func (f *fragment) unprotectedColumnRows(columnID uint64) []uint64 {
+	// Number of containers that make up a single row.
+	const containersPerRow = ShardWidth >> 16
+	offset := (columnID % ShardWidth) >> 16
+
+	var rowIDs []uint64
+	key := offset
+	for {
+		itr, _ := f.storage.Containers.Iterator(key)
+		if !itr.Next() {
+			break
+		}
+		k, _ := itr.Value()
+		rowID := k / containersPerRow
+
+		// Seek to the column's container in this row or the next.
+		if k%containersPerRow < offset {
+			key = rowID*containersPerRow + offset
+			continue
+		} else if k%containersPerRow > offset {
+			key = (rowID+1)*containersPerRow + offset
+			continue
+		}
+
+		if f.storage.Contains(pos(rowID, columnID)) {
+			rowIDs = append(rowIDs, rowID)
+		}
+		key = (rowID+1)*containersPerRow + offset
+	}
+	return rowIDs
+}
+
+// contains returns true if the bit for a row & column is set.
+func (f *fragment) contains(rowID, columnID uint64) (bool, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.bit(rowID, columnID)
+}
+
 func (f *fragment) bit(rowID, columnID uint64) (bool, error) {
 	pos, err := f.pos(rowID, columnID)
 	if err != nil {
@@ -468,7 +573,10 @@ func (f *fragment) bit(rowID, columnID uint64) (bool, error) {
 func (f *fragment) value(columnID uint64, bitDepth uint) (value uint64, exists bool, err error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	return f.unprotectedValue(columnID, bitDepth)
+}
 
+func (f *fragment) unprotectedValue(columnID uint64, bitDepth uint) (value uint64, exists bool, err error) {
 	// If existence bit is unset then ignore remaining bits.
 	if v, err := f.bit(uint64(bitDepth), columnID); err != nil {
 		return 0, false, errors.Wrap(err, "getting existence bit")
@@ -488,11 +596,55 @@ func (f *fragment) value(columnID uint64, bitDepth uint) (value uint64, exists b
 	return value, true, nil
 }
 
+// values returns the values for a sorted list of columns in a bsiGroup. This is
+// the bulk equivalent of value(): each bit slice is read once rather than
+// checking individual bits for every column.
+func (f *fragment) values(columnIDs []uint64, bitDepth uint) (values []uint64, exists []bool) {
+	values = make([]uint64, len(columnIDs))
+	exists = make([]bool, len(columnIDs))
+	if len(columnIDs) == 0 {
+		return values, exists
+	}
+
+	// Walk the existence row followed by each bit slice, merging its
+	// columns against the requested ones.
+	for i := uint(0); i <= bitDepth; i++ {
+		j := 0
+		for _, columnID := range f.row(uint64(i)).Columns() {
+			for j < len(columnIDs) && columnIDs[j] < columnID {
+				j++
+			}
+			if j == len(columnIDs) {
+				break
+			} else if columnIDs[j] != columnID {
+				continue
+			}
+
+			if i == bitDepth {
+				exists[j] = true
+			} else {
+				values[j] |= 1 << i
+			}
+		}
+	}
+
+	// Ignore any stale bits on columns without a value.
+	for j := range values {
+		if !exists[j] {
+			values[j] = 0
+		}
+	}
+	return values, exists
+}
+
 // setValue uses a column of bits to set a multi-bit value.
 func (f *fragment) setValue(columnID uint64, bitDepth uint, value uint64) (changed bool, err error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	return f.unprotectedSetValue(columnID, bitDepth, value)
+}
 
+func (f *fragment) unprotectedSetValue(columnID uint64, bitDepth uint, value uint64) (changed bool, err error) {
 	for i := uint(0); i < bitDepth; i++ {
 		if value&(1<<i) != 0 {
 			if c, err := f.unprotectedSetBit(uint64(i), columnID); err != nil {
@@ -519,6 +671,85 @@ func (f *fragment) setValue(columnID uint64, bitDepth uint, value uint64) (chang
 	return changed, nil
 }
 
+// setValueIf sets a multi-bit value only if the column currently holds the
+// expected value, or holds no value if expected is nil. The comparison and
+// the write happen under a single lock. Returns true if the value was set.
+func (f *fragment) setValueIf(columnID uint64, bitDepth uint, value uint64, expected *uint64) (applied bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, exists, err := f.unprotectedValue(columnID, bitDepth)
+	if err != nil {
+		return false, errors.Wrap(err, "reading value")
+	} else if expected == nil && exists {
+		return false, nil
+	} else if expected != nil && (!exists || v != *expected) {
+		return false, nil
+	}
+
+	if _, err := f.unprotectedSetValue(columnID, bitDepth, value); err != nil {
+		return false, errors.Wrap(err, "setting value")
+	}
+	return true, nil
+}
+
+// incrementValue adds delta to the multi-bit value of a column and returns
+// the new value. A column without a value starts from zero, which is the base
+// value of the field's zero and may be negative. The read and the write happen
+// under a single lock so concurrent increments are not lost. The value is left
+// unchanged if the result falls outside of 0 to max.
+func (f *fragment) incrementValue(columnID uint64, bitDepth uint, delta, zero int64, max uint64) (value uint64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	v, exists, err := f.unprotectedValue(columnID, bitDepth)
+	if err != nil {
+		return 0, errors.Wrap(err, "reading value")
+	}
+
+	current := zero
+	if exists {
+		current = int64(v)
+	}
+	next, err := addBaseValue(current, delta, max)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := f.unprotectedSetValue(columnID, bitDepth, next); err != nil {
+		return 0, errors.Wrap(err, "setting value")
+	}
+	return next, nil
+}
+
+// addBaseValue adds delta to a base value, ensuring the result lies within
+// 0 to max and that the addition does not overflow.
+func addBaseValue(value, delta int64, max uint64) (uint64, error) {
+	next := value + delta
+	if (delta > 0 && next < value) || (next >= 0 && uint64(next) > max) {
+		return 0, ErrBSIGroupValueTooHigh
+	} else if (delta < 0 && next > value) || next < 0 {
+		return 0, ErrBSIGroupValueTooLow
+	}
+	return uint64(next), nil
+}
+
+// clearValue removes the multi-bit value for a column by clearing its
+// not-null bit and every value bit.
+func (f *fragment) clearValue(columnID uint64, bitDepth uint) (changed bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := uint(0); i <= bitDepth; i++ {
+		if c, err := f.unprotectedClearBit(uint64(i), columnID); err != nil {
+			return changed, err
+		} else if c {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
 // importSetValue is a more efficient SetValue just for imports.
 func (f *fragment) importSetValue(columnID uint64, bitDepth uint, value uint64) (changed bool, err error) {
 
@@ -560,6 +791,22 @@ func (f *fragment) importSetValue(columnID uint64, bitDepth uint, value uint64)
 	return changed, nil
 }
 
+// importClearValue is a more efficient clearValue just for imports.
+func (f *fragment) importClearValue(columnID uint64, bitDepth uint) (changed bool, err error) {
+	for i := uint(0); i <= bitDepth; i++ {
+		bit, err := f.pos(uint64(i), columnID)
+		if err != nil {
+			return changed, errors.Wrap(err, "getting clear pos")
+		}
+		if c, err := f.storage.Remove(bit); err != nil {
+			return changed, errors.Wrap(err, "removing")
+		} else if c {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
 // sum returns the sum of a given bsiGroup as well as the number of columns involved.
 // A bitmap can be passed in to optionally filter the computed columns.
 func (f *fragment) sum(filter *Row, bitDepth uint) (sum, count uint64, err error) {
@@ -656,6 +903,86 @@ func (f *fragment) max(filter *Row, bitDepth uint) (max, count uint64, err error
 	return max, count, nil
 }
 
+// topK returns up to k columns with the largest values in a given bsiGroup,
+// or the smallest if bottom is true, along with their values. Columns are
+// ordered by value and then by column ID. A bitmap can be passed in to
+// optionally filter the considered columns.
+func (f *fragment) topK(filter *Row, bitDepth uint, k uint64, bottom bool) (columnIDs, values []uint64, err error) {
+	consider := f.row(uint64(bitDepth))
+	if filter != nil {
+		consider = consider.Intersect(filter)
+	}
+
+	// If there are no columns to consider, return early.
+	if k == 0 || consider.Count() == 0 {
+		return nil, nil, nil
+	}
+
+	// Walk down from the highest bit. Columns which are preferred at a bit
+	// (set for top, unset for bottom) are kept as long as there is room for
+	// all of them. Otherwise the remaining columns are narrowed down to the
+	// preferred ones. Columns left in consider at the end share a value.
+	keep := NewRow()
+	for i := bitDepth; i > uint(0); i-- {
+		ii := i - 1 // allow for uint range: (bitDepth-1) to 0
+		row := f.row(uint64(ii))
+
+		var preferred *Row
+		if bottom {
+			preferred = consider.Difference(row)
+		} else {
+			preferred = consider.Intersect(row)
+		}
+
+		n := keep.Count() + preferred.Count()
+		if n > k {
+			consider = preferred
+		} else {
+			keep = keep.Union(preferred)
+			consider = consider.Difference(preferred)
+			if n == k {
+				// The kept columns are the result; the rest rank lower.
+				consider = NewRow()
+				break
+			}
+		}
+	}
+
+	// Read the values of the matching columns from the bit slices.
+	columnIDs = keep.Union(consider).Columns()
+	values, _ = f.values(columnIDs, bitDepth)
+
+	// Order columns by value and truncate ties at the boundary.
+	sort.Sort(columnValueSlice{columnIDs: columnIDs, values: values, bottom: bottom})
+	if uint64(len(columnIDs)) > k {
+		columnIDs, values = columnIDs[:k], values[:k]
+	}
+	return columnIDs, values, nil
+}
+
+// columnValueSlice sorts parallel column & value slices by value and then by
+// column ID. Values are sorted in descending order unless bottom is set.
+type columnValueSlice struct {
+	columnIDs []uint64
+	values    []uint64
+	bottom    bool
+}
+
+func (p columnValueSlice) Len() int { return len(p.columnIDs) }
+func (p columnValueSlice) Swap(i, j int) {
+	p.columnIDs[i], p.columnIDs[j] = p.columnIDs[j], p.columnIDs[i]
+	p.values[i], p.values[j] = p.values[j], p.values[i]
+}
+func (p columnValueSlice) Less(i, j int) bool {
+	if p.values[i] != p.values[j] {
+		if p.bottom {
+			return p.values[i] < p.values[j]
+		}
+		return p.values[i] > p.values[j]
+	}
+	return p.columnIDs[i] < p.columnIDs[j]
+}
+
 // rangeOp returns bitmaps with a bsiGroup value encoding matching the predicate.
 func (f *fragment) rangeOp(op pql.Token, bitDepth uint, predicate uint64) (*Row, error) {
 	switch op {
@@ -867,7 +1194,7 @@ func (f *fragment) forEachBit(fn func(rowID, columnID uint64) error) error {
 // top returns the top rows from the fragment.
 // If opt.Src is specified then only rows which intersect src are returned.
 // If opt.FilterValues exist then the row attribute specified by field is matched.
-func (f *fragment) top(opt topOptions) ([]Pair, error) {
+func (f *fragment) top(ctx context.Context, opt topOptions) ([]Pair, error) {
 	// Retrieve pairs. If no row ids specified then return from cache.
 	pairs := f.topBitmapPairs(opt.RowIDs)
 
@@ -899,6 +1226,11 @@ func (f *fragment) top(opt topOptions) ([]Pair, error) {
 	// Iterate over rankings and add to results until we have enough.
 	results := &pairHeap{}
 	for _, pair := range pairs {
+		// Stop once the query is cancelled or times out.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		rowID, cnt := pair.ID, pair.Count
 
 		// Ignore empty rows.
@@ -1057,6 +1389,67 @@ type topOptions struct {
 	TanimotoThreshold uint64
 }
 
+// rows returns a sorted list of row IDs which have at least one bit set in
+// the fragment. The container keys are walked directly so that rows do not
+// need to be materialized.
+func (f *fragment) rows(opt rowsOptions) []uint64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.unprotectedRows(opt)
+}
+
+func (f *fragment) unprotectedRows(opt rowsOptions) []uint64 {
+	// Number of containers that make up a single row.
+	const containersPerRow = ShardWidth >> 16
+
+	// Rows past the largest row ID cannot exist; computing their position
+	// would overflow and restart iteration from the first row.
+	if opt.Start > math.MaxUint64/ShardWidth {
+		return nil
+	}
+
+	var rowIDs []uint64
+	var prev uint64
+	var seen bool
+	itr, _ := f.storage.Containers.Iterator((opt.Start * ShardWidth) >> 16)
+	for itr.Next() {
+		key, c := itr.Value()
+		if c.N() == 0 {
+			continue
+		}
+
+		// Skip remaining containers of a row which was already handled.
+		rowID := key / containersPerRow
+		if seen && rowID == prev {
+			continue
+		}
+		prev, seen = rowID, true
+
+		// Only include rows containing the column, if specified.
+		if opt.Column != nil && !f.storage.Contains(pos(rowID, *opt.Column)) {
+			continue
+		}
+
+		rowIDs = append(rowIDs, rowID)
+		if opt.Limit > 0 && len(rowIDs) >= opt.Limit {
+			break
+		}
+	}
+	return rowIDs
+}
+
+// rowsOptions represents options passed into the rows() function.
+type rowsOptions struct {
+	// Row ID to begin listing from (inclusive).
+	Start uint64
+
+	// Maximum number of rows to return. Zero means no limit.
+	Limit int
+
+	// If set, only rows with a bit set for this column are returned.
+	Column *uint64
+}
+
 // Checksum returns a checksum for the entire fragment.
 // If two fragments have the same checksum then they have the same data.
 func (f *fragment) Checksum() []byte {
@@ -1216,8 +1609,9 @@ func (f *fragment) mergeBlock(id int, data []pairSet) (sets, clears []pairSet, e
 	// If there is an even split then a set is used.
 	majorityN := (len(itrs) + 1) / 2
 
-	// Iterate over all values in all iterators to determine differences.
-	values := make([]bool, len(itrs))
+	// Iterate over all values in all iterators to determine the consensus
+	// value of each pair.
+	var points []mergePoint
 	for {
 		var min struct {
 			rowID    uint64
@@ -1243,42 +1637,72 @@ func (f *fragment) mergeBlock(id int, data []pairSet) (sets, clears []pairSet, e
 		}
 
 		// Determine consensus of point.
-		var setN int
+		p := mergePoint{rowID: min.rowID, columnID: min.columnID, values: make([]bool, len(itrs))}
 		for i, itr := range itrs {
 			bid, pid, eof := itr.Next()
 
-			values[i] = !eof && bid == min.rowID && pid == min.columnID
-			if values[i] {
-				setN++ // set
+			p.values[i] = !eof && bid == min.rowID && pid == min.columnID
+			if p.values[i] {
+				p.setN++ // set
 			} else {
 				itr.Unread() // clear
 			}
 		}
 
 		// Determine consensus value.
-		newValue := setN >= majorityN
+		p.newValue = p.setN >= majorityN
+		points = append(points, p)
+	}
+
+	// A mutex column may only be set in a single row. If the consensus sets
+	// a column in multiple rows then keep the row set in the most blocks,
+	// using the lowest row ID to break ties, and clear the others.
+	if f.mutex {
+		winners := make(map[uint64]int)
+		for i, p := range points {
+			if !p.newValue {
+				continue
+			}
+			if j, ok := winners[p.columnID]; !ok || p.setN > points[j].setN {
+				winners[p.columnID] = i
+			}
+		}
+		for i := range points {
+			if points[i].newValue && winners[points[i].columnID] != i {
+				points[i].newValue = false
+			}
+		}
+	}
 
-		// Add a diff for any node with a different value.
+	// Add a diff for any node with a different value.
+	for _, p := range points {
 		for i := range itrs {
 			// Value matches, ignore.
-			if values[i] == newValue {
+			if p.values[i] == p.newValue {
 				continue
 			}
 
 			// Append to either the set or clear diff.
-			if newValue {
-				sets[i].rowIDs = append(sets[i].rowIDs, min.rowID)
-				sets[i].columnIDs = append(sets[i].columnIDs, min.columnID)
+			if p.newValue {
+				sets[i].rowIDs = append(sets[i].rowIDs, p.rowID)
+				sets[i].columnIDs = append(sets[i].columnIDs, p.columnID)
 			} else {
-				clears[i].rowIDs = append(sets[i].rowIDs, min.rowID)
-				clears[i].columnIDs = append(sets[i].columnIDs, min.columnID)
+				clears[i].rowIDs = append(clears[i].rowIDs, p.rowID)
+				clears[i].columnIDs = append(clears[i].columnIDs, p.columnID)
 			}
 		}
 	}
 
-	// Set local bits.
+	// Set local bits. Mutex fields also clear the column in rows outside of
+	// the block being merged.
 	for i := range sets[0].columnIDs {
-		if _, err := f.unprotectedSetBit(sets[0].rowIDs[i], (f.shard*ShardWidth)+sets[0].columnIDs[i]); err != nil {
+		rowID, columnID := sets[0].rowIDs[i], (f.shard*ShardWidth)+sets[0].columnIDs[i]
+		if f.mutex {
+			if _, err := f.unprotectedClearMutexColumn(rowID, columnID); err != nil {
+				return nil, nil, errors.Wrap(err, "clearing mutex column")
+			}
+		}
+		if _, err := f.unprotectedSetBit(rowID, columnID); err != nil {
 			return nil, nil, errors.Wrap(err, "setting")
 		}
 	}
@@ -1293,6 +1717,15 @@ func (f *fragment) mergeBlock(id int, data []pairSet) (sets, clears []pairSet, e
 	return sets[1:], clears[1:], nil
 }
 
+// mergePoint is a row/column pair and its state in each block being merged.
+type mergePoint struct {
+	rowID    uint64
+	columnID uint64
+	values   []bool
+	setN     int
+	newValue bool
+}
+
 // bulkImport bulk imports a set of bits and then snapshots the storage.
 // This does not affect the fragment's cache.
 func (f *fragment) bulkImport(rowIDs, columnIDs []uint64) error {
@@ -1311,6 +1744,21 @@ func (f *fragment) bulkImport(rowIDs, columnIDs []uint64) error {
 	lastID := uint64(0)
 	if err := func() error {
 		set := make(map[uint64]struct{})
+
+		// Mutex fields keep only the last row imported for each column and
+		// clear the column from any other existing rows.
+		if f.mutex {
+			rowIDs, columnIDs = lastMutexPairs(rowIDs, columnIDs)
+			cleared, err := f.unprotectedImportMutexClears(rowIDs, columnIDs)
+			if err != nil {
+				return errors.Wrap(err, "clearing mutex columns")
+			}
+			for _, rowID := range cleared {
+				set[rowID] = struct{}{}
+				delete(f.checksums, int(rowID/HashBlockSize))
+			}
+		}
+
 		for i := range rowIDs {
 			rowID, columnID := rowIDs[i], columnIDs[i]
 
@@ -1363,12 +1811,119 @@ func (f *fragment) bulkImport(rowIDs, columnIDs []uint64) error {
 	return nil
 }
 
-// importValue bulk imports a set of range-encoded values.
-func (f *fragment) importValue(columnIDs, values []uint64, bitDepth uint) error {
+// lastMutexPairs returns the row/column pairs with only the last pair for
+// each column retained.
+func lastMutexPairs(rowIDs, columnIDs []uint64) ([]uint64, []uint64) {
+	last := make(map[uint64]int, len(columnIDs))
+	for i, columnID := range columnIDs {
+		last[columnID] = i
+	}
+	if len(last) == len(columnIDs) {
+		return rowIDs, columnIDs
+	}
+
+	otherRowIDs := make([]uint64, 0, len(last))
+	otherColumnIDs := make([]uint64, 0, len(last))
+	for i, columnID := range columnIDs {
+		if last[columnID] == i {
+			otherRowIDs = append(otherRowIDs, rowIDs[i])
+			otherColumnIDs = append(otherColumnIDs, columnID)
+		}
+	}
+	return otherRowIDs, otherColumnIDs
+}
+
+// unprotectedImportMutexClears removes the bits for the imported columns from
+// all existing rows other than the imported row. The storage is modified
+// directly, as in bulkImport, and the IDs of the changed rows are returned.
+func (f *fragment) unprotectedImportMutexClears(rowIDs, columnIDs []uint64) ([]uint64, error) {
+	// Imported row of each column, by the column's position in the shard.
+	imported := make(map[uint64]uint64, len(columnIDs))
+	positions := make([]uint64, len(columnIDs))
+	for i, columnID := range columnIDs {
+		positions[i] = columnID % ShardWidth
+		imported[positions[i]] = rowIDs[i]
+	}
+	columns := roaring.NewBitmap(positions...)
+
+	var changed []uint64
+	for _, existingID := range f.unprotectedRows(rowsOptions{}) {
+		// Only visit the imported columns which are set in the row.
+		row := f.storage.OffsetRange(0, existingID*ShardWidth, (existingID+1)*ShardWidth)
+		var n int
+		for _, column := range row.Intersect(columns).Slice() {
+			if imported[column] == existingID {
+				continue
+			}
+			if v, err := f.storage.Remove(pos(existingID, column)); err != nil {
+				return nil, errors.Wrap(err, "clearing")
+			} else if v {
+				n++
+			}
+		}
+		if n > 0 {
+			changed = append(changed, existingID)
+		}
+	}
+	return changed, nil
+}
+
+// setRow replaces the contents of a row with the columns of row that fall
+// within the fragment's shard. As with bulkImport, the containers are swapped
+// directly in storage and the fragment is snapshotted rather than logging
+// each bit.
+func (f *fragment) setRow(rowID uint64, row *Row) (changed bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	const containersPerRow = ShardWidth >> 16
+
+	data := roaring.NewBitmap()
+	if seg := row.segment(f.shard); seg != nil {
+		data = seg.data.OffsetRange(rowID*ShardWidth, f.shard*ShardWidth, (f.shard+1)*ShardWidth)
+	}
+
+	// Leave storage untouched if the row already matches.
+	existing := f.storage.OffsetRange(rowID*ShardWidth, rowID*ShardWidth, (rowID+1)*ShardWidth)
+	n := data.Count()
+	if existing.Count() == n && existing.IntersectionCount(data) == n {
+		return false, nil
+	}
+
+	// Disconnect op writer so we don't append updates.
+	f.storage.OpWriter = nil
+
+	// Replace every container in the row, cloning the new containers as
+	// they may be shared with the source row.
+	key := (rowID * ShardWidth) >> 16
+	for i := uint64(0); i < containersPerRow; i++ {
+		f.storage.Containers.Remove(key + i)
+	}
+	itr, _ := data.Containers.Iterator(0)
+	for itr.Next() {
+		k, c := itr.Value()
+		f.storage.Containers.Put(k, c.Clone())
+	}
+
+	// Invalidate block checksum and refresh the cached row and count.
+	delete(f.checksums, int(rowID/HashBlockSize))
+	f.cache.BulkAdd(rowID, f.unprotectedRow(rowID, false, true).Count())
+	f.cache.Invalidate()
+
+	// Write the storage to disk and reload.
+	if err := f.snapshot(); err != nil {
+		return false, errors.Wrap(err, "snapshotting")
+	}
+	return true, nil
+}
+
+// importValue bulk imports a set of range-encoded values. If clear is true,
+// the values of the columns are removed instead and values is ignored.
+func (f *fragment) importValue(columnIDs, values []uint64, bitDepth uint, clear bool) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	// Verify that there are an equal number of column ids and values.
-	if len(columnIDs) != len(values) {
+	if !clear && len(columnIDs) != len(values) {
 		return fmt.Errorf("mismatch of column/value len: %d != %d", len(columnIDs), len(values))
 	}
 
@@ -1377,9 +1932,14 @@ func (f *fragment) importValue(columnIDs, values []uint64, bitDepth uint) error
 	// If an error occurs then reopen the storage.
 	if err := func() error {
 		for i := range columnIDs {
-			columnID, value := columnIDs[i], values[i]
+			if clear {
+				if _, err := f.importClearValue(columnIDs[i], bitDepth); err != nil {
+					return errors.Wrap(err, "clearing")
+				}
+				continue
+			}
 
-			_, err := f.importSetValue(columnID, bitDepth, value)
+			_, err := f.importSetValue(columnIDs[i], bitDepth, values[i])
 			if err != nil {
 				return errors.Wrap(err, "setting")
 			}
@@ -1396,6 +1956,58 @@ func (f *fragment) importValue(columnIDs, values []uint64, bitDepth uint) error
 	return nil
 }
 
+// importIncrementValue bulk adds a set of deltas to range-encoded values.
+// See incrementValue for the meaning of zero and max. All new values are
+// computed before any are written, so no values are changed if one of them
+// falls out of range.
+func (f *fragment) importIncrementValue(columnIDs []uint64, deltas []int64, bitDepth uint, zero int64, max uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// Verify that there are an equal number of column ids and deltas.
+	if len(columnIDs) != len(deltas) {
+		return fmt.Errorf("mismatch of column/delta len: %d != %d", len(columnIDs), len(deltas))
+	}
+
+	// Compute new values, accumulating deltas for repeated columns.
+	values := make(map[uint64]uint64, len(columnIDs))
+	for i, columnID := range columnIDs {
+		current := zero
+		if v, ok := values[columnID]; ok {
+			current = int64(v)
+		} else if v, exists, err := f.unprotectedValue(columnID, bitDepth); err != nil {
+			return errors.Wrap(err, "reading value")
+		} else if exists {
+			current = int64(v)
+		}
+
+		next, err := addBaseValue(current, deltas[i], max)
+		if err != nil {
+			return errors.Wrapf(err, "columnID=%v, delta=%v", columnID, deltas[i])
+		}
+		values[columnID] = next
+	}
+
+	f.storage.OpWriter = nil
+	// Write every value.
+	// If an error occurs then reopen the storage.
+	if err := func() error {
+		for columnID, value := range values {
+			if _, err := f.importSetValue(columnID, bitDepth, value); err != nil {
+				return errors.Wrap(err, "setting")
+			}
+		}
+		return nil
+	}(); err != nil {
+		_ = f.closeStorage()
+		_ = f.openStorage()
+		return err
+	}
+	if err := f.snapshot(); err != nil {
+		return errors.Wrap(err, "snapshotting")
+	}
+	return nil
+}
+
 // incrementOpN increase the operation count by one.
 // If the count exceeds the maximum allowed then a snapshot is performed.
 func (f *fragment) incrementOpN() error {
@@ -16,6 +16,7 @@ package pilosa
 
 import (
 	"bytes"
+	"context"
 	"flag"
 	"io/ioutil"
 	"math"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pilosa/pilosa/pql"
+	"github.com/pkg/errors"
 )
 
 // Test flags
@@ -92,6 +94,179 @@ func TestFragment_ClearBit(t *testing.T) {
 	}
 }
 
+// Ensure a fragment can replace a row with the shard's columns of another row.
+func TestFragment_SetRow(t *testing.T) {
+	f := mustOpenFragment("i", "f", ViewStandard, 1, CacheTypeRanked)
+	defer f.Close()
+
+	f.mustSetBits(100, ShardWidth+1, ShardWidth+2, ShardWidth+70000)
+	f.mustSetBits(101, ShardWidth+1)
+	f.RecalculateCache()
+
+	// Columns outside of the fragment's shard are ignored.
+	row := NewRow(3, ShardWidth+2, ShardWidth+3, ShardWidth+4, ShardWidth+3*65536, 2*ShardWidth+1)
+	if changed, err := f.setRow(101, row); err != nil {
+		t.Fatal(err)
+	} else if !changed {
+		t.Fatal("expected change")
+	}
+
+	exp := []uint64{ShardWidth + 2, ShardWidth + 3, ShardWidth + 4, ShardWidth + 3*65536}
+	if cols := f.row(101).Columns(); !reflect.DeepEqual(cols, exp) {
+		t.Fatalf("unexpected columns: %v", cols)
+	} else if cols := f.row(100).Columns(); !reflect.DeepEqual(cols, []uint64{ShardWidth + 1, ShardWidth + 2, ShardWidth + 70000}) {
+		t.Fatalf("unexpected columns in neighbouring row: %v", cols)
+	}
+
+	// Ensure the rank cache reflects the new count.
+	if n := f.cache.Get(101); n != 4 {
+		t.Fatalf("unexpected cache count: %d", n)
+	}
+
+	// Storing the same row again is not a change.
+	if changed, err := f.setRow(101, row); err != nil {
+		t.Fatal(err)
+	} else if changed {
+		t.Fatal("expected no change")
+	}
+
+	// Storing an empty row clears it.
+	if changed, err := f.setRow(100, NewRow()); err != nil {
+		t.Fatal(err)
+	} else if !changed {
+		t.Fatal("expected change")
+	}
+
+	if err := f.reopen(); err != nil {
+		t.Fatal(err)
+	} else if n := f.row(100).Count(); n != 0 {
+		t.Fatalf("unexpected count (reopen): %d", n)
+	} else if cols := f.row(101).Columns(); !reflect.DeepEqual(cols, exp) {
+		t.Fatalf("unexpected columns (reopen): %v", cols)
+	}
+}
+
+// Ensure a mutex fragment only allows a single row per column.
+func TestFragment_Mutex(t *testing.T) {
+	t.Run("SetBit", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		f.mutex = true
+		defer f.Close()
+
+		if changed, err := f.setBit(1, 100); err != nil {
+			t.Fatal(err)
+		} else if !changed {
+			t.Fatal("expected change")
+		} else if changed, err := f.setBit(2, 100); err != nil {
+			t.Fatal(err)
+		} else if !changed {
+			t.Fatal("expected change")
+		} else if changed, err := f.setBit(2, 100); err != nil {
+			t.Fatal(err)
+		} else if changed {
+			t.Fatal("expected no change")
+		} else if _, err := f.setBit(1, 101); err != nil {
+			t.Fatal(err)
+		}
+
+		if cols := f.row(1).Columns(); !reflect.DeepEqual(cols, []uint64{101}) {
+			t.Fatalf("unexpected columns: %v", cols)
+		} else if cols := f.row(2).Columns(); !reflect.DeepEqual(cols, []uint64{100}) {
+			t.Fatalf("unexpected columns: %v", cols)
+		}
+
+		// Verify the cache reflects the cleared bit.
+		if n := f.cache.Get(1); n != 1 {
+			t.Fatalf("unexpected cached count for row 1: %d", n)
+		} else if n := f.cache.Get(2); n != 1 {
+			t.Fatalf("unexpected cached count for row 2: %d", n)
+		}
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		f.mutex = true
+		defer f.Close()
+
+		if _, err := f.setBit(1, 100); err != nil {
+			t.Fatal(err)
+		} else if _, err := f.setBit(1, 101); err != nil {
+			t.Fatal(err)
+		} else if _, err := f.setBit(1, 70000); err != nil {
+			t.Fatal(err)
+		}
+
+		// The last row for a column within an import wins.
+		if err := f.bulkImport([]uint64{2, 3, 4, 2}, []uint64{100, 102, 102, 70000}); err != nil {
+			t.Fatal(err)
+		}
+
+		if cols := f.row(1).Columns(); !reflect.DeepEqual(cols, []uint64{101}) {
+			t.Fatalf("unexpected row 1: %v", cols)
+		} else if cols := f.row(2).Columns(); !reflect.DeepEqual(cols, []uint64{100, 70000}) {
+			t.Fatalf("unexpected row 2: %v", cols)
+		} else if n := f.row(3).Count(); n != 0 {
+			t.Fatalf("unexpected row 3 count: %d", n)
+		} else if cols := f.row(4).Columns(); !reflect.DeepEqual(cols, []uint64{102}) {
+			t.Fatalf("unexpected row 4: %v", cols)
+		}
+	})
+
+	t.Run("ColumnRows", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		defer f.Close()
+
+		// Bits in other containers of the same rows are skipped.
+		for _, p := range [][2]uint64{{0, 70000}, {3, 5}, {3, 70000}, {5, 200000}, {7, 70000}, {7, 70001}, {9, 65535}} {
+			if _, err := f.setBit(p[0], p[1]); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if rowIDs := f.unprotectedColumnRows(70000); !reflect.DeepEqual(rowIDs, []uint64{0, 3, 7}) {
+			t.Fatalf("unexpected rows: %v", rowIDs)
+		} else if rowIDs := f.unprotectedColumnRows(200000); !reflect.DeepEqual(rowIDs, []uint64{5}) {
+			t.Fatalf("unexpected rows: %v", rowIDs)
+		} else if rowIDs := f.unprotectedColumnRows(6); rowIDs != nil {
+			t.Fatalf("unexpected rows: %v", rowIDs)
+		}
+	})
+
+	t.Run("MergeBlock", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		f.mutex = true
+		defer f.Close()
+
+		if _, err := f.setBit(1, 5); err != nil {
+			t.Fatal(err)
+		}
+
+		// A tie keeps the lowest row on all blocks.
+		sets, clears, err := f.mergeBlock(0, []pairSet{{rowIDs: []uint64{2}, columnIDs: []uint64{5}}})
+		if err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(sets[0], pairSet{rowIDs: []uint64{1}, columnIDs: []uint64{5}}) {
+			t.Fatalf("unexpected sets: %+v", sets)
+		} else if !reflect.DeepEqual(clears[0], pairSet{rowIDs: []uint64{2}, columnIDs: []uint64{5}}) {
+			t.Fatalf("unexpected clears: %+v", clears)
+		} else if cols := f.row(1).Columns(); !reflect.DeepEqual(cols, []uint64{5}) {
+			t.Fatalf("unexpected local row: %v", cols)
+		}
+
+		// The majority row replaces the local row.
+		if _, _, err := f.mergeBlock(0, []pairSet{
+			{rowIDs: []uint64{2}, columnIDs: []uint64{5}},
+			{rowIDs: []uint64{2}, columnIDs: []uint64{5}},
+		}); err != nil {
+			t.Fatal(err)
+		} else if n := f.row(1).Count(); n != 0 {
+			t.Fatalf("unexpected row 1 count: %d", n)
+		} else if cols := f.row(2).Columns(); !reflect.DeepEqual(cols, []uint64{5}) {
+			t.Fatalf("unexpected row 2: %v", cols)
+		}
+	})
+}
+
 // Ensure a fragment can set & read a value.
 func TestFragment_SetValue(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
@@ -215,6 +390,153 @@ func TestFragment_SetValue(t *testing.T) {
 	})
 }
 
+// Ensure a fragment only sets a value when the column holds the expected value.
+func TestFragment_SetValueIf(t *testing.T) {
+	f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+	defer f.Close()
+
+	expected := func(v uint64) *uint64 { return &v }
+	for i, tt := range []struct {
+		value    uint64
+		expected *uint64
+		applied  bool
+		exp      uint64
+	}{
+		{value: 10, expected: expected(0), applied: false},
+		{value: 10, expected: nil, applied: true, exp: 10},
+		{value: 20, expected: nil, applied: false, exp: 10},
+		{value: 20, expected: expected(11), applied: false, exp: 10},
+		{value: 20, expected: expected(10), applied: true, exp: 20},
+	} {
+		if applied, err := f.setValueIf(100, 8, tt.value, tt.expected); err != nil {
+			t.Fatal(err)
+		} else if applied != tt.applied {
+			t.Fatalf("%d. unexpected applied: %v", i, applied)
+		} else if v, _, err := f.value(100, 8); err != nil {
+			t.Fatal(err)
+		} else if v != tt.exp {
+			t.Fatalf("%d. unexpected value: %d", i, v)
+		}
+	}
+}
+
+// Ensure a fragment can add to a column's value.
+func TestFragment_IncrementValue(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		defer f.Close()
+
+		// A column without a value starts at the zero base value.
+		if v, err := f.incrementValue(100, 8, 5, 10, 200); err != nil {
+			t.Fatal(err)
+		} else if v != 15 {
+			t.Fatalf("unexpected value: %d", v)
+		} else if v, err := f.incrementValue(100, 8, -15, 10, 200); err != nil {
+			t.Fatal(err)
+		} else if v != 0 {
+			t.Fatalf("unexpected value: %d", v)
+		}
+
+		// Ensure out of range results leave the value unchanged.
+		if _, err := f.incrementValue(100, 8, -1, 10, 200); err != ErrBSIGroupValueTooLow {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := f.incrementValue(100, 8, 201, 10, 200); err != ErrBSIGroupValueTooHigh {
+			t.Fatalf("unexpected error: %v", err)
+		} else if v, exists, err := f.value(100, 8); err != nil {
+			t.Fatal(err)
+		} else if !exists || v != 0 {
+			t.Fatalf("unexpected value: %d (exists=%v)", v, exists)
+		}
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		defer f.Close()
+
+		if _, err := f.setValue(1, 8, 50); err != nil {
+			t.Fatal(err)
+		} else if err := f.importIncrementValue([]uint64{1, 2, 1}, []int64{5, 3, -20}, 8, 10, 200); err != nil {
+			t.Fatal(err)
+		}
+
+		if v, _, err := f.value(1, 8); err != nil {
+			t.Fatal(err)
+		} else if v != 35 {
+			t.Fatalf("unexpected value: %d", v)
+		} else if v, _, err := f.value(2, 8); err != nil {
+			t.Fatal(err)
+		} else if v != 13 {
+			t.Fatalf("unexpected value: %d", v)
+		}
+
+		// Ensure no values are written if any result is out of range.
+		if err := f.importIncrementValue([]uint64{2, 1}, []int64{1, 500}, 8, 10, 200); errors.Cause(err) != ErrBSIGroupValueTooHigh {
+			t.Fatalf("unexpected error: %v", err)
+		} else if v, _, err := f.value(2, 8); err != nil {
+			t.Fatal(err)
+		} else if v != 13 {
+			t.Fatalf("unexpected value: %d", v)
+		}
+	})
+}
+
+// Ensure a fragment can clear a column's value.
+func TestFragment_ClearValue(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		defer f.Close()
+
+		if _, err := f.setValue(100, 16, 3829); err != nil {
+			t.Fatal(err)
+		} else if changed, err := f.clearValue(100, 16); err != nil {
+			t.Fatal(err)
+		} else if !changed {
+			t.Fatal("expected change")
+		}
+
+		// Ensure no value remains and no bits are left behind.
+		if _, exists, err := f.value(100, 16); err != nil {
+			t.Fatal(err)
+		} else if exists {
+			t.Fatal("expected value to not exist")
+		} else if n := f.storage.Count(); n != 0 {
+			t.Fatalf("unexpected bit count: %d", n)
+		}
+
+		// Clearing again should return no change.
+		if changed, err := f.clearValue(100, 16); err != nil {
+			t.Fatal(err)
+		} else if changed {
+			t.Fatal("expected no change")
+		}
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+		defer f.Close()
+
+		if err := f.importValue([]uint64{1, 2, 3}, []uint64{10, 20, 30}, 16, false); err != nil {
+			t.Fatal(err)
+		} else if err := f.importValue([]uint64{1, 3}, nil, 16, true); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, exists, err := f.value(1, 16); err != nil {
+			t.Fatal(err)
+		} else if exists {
+			t.Fatal("expected value to not exist")
+		} else if value, exists, err := f.value(2, 16); err != nil {
+			t.Fatal(err)
+		} else if !exists || value != 20 {
+			t.Fatalf("unexpected value: %d (exists=%v)", value, exists)
+		} else if sum, n, err := f.sum(nil, 16); err != nil {
+			t.Fatal(err)
+		} else if sum != 20 || n != 1 {
+			t.Fatalf("unexpected sum: %d (n=%d)", sum, n)
+		}
+	})
+}
+
 // Ensure a fragment can sum values.
 func TestFragment_Sum(t *testing.T) {
 	const bitDepth = 16
@@ -327,6 +649,88 @@ func TestFragment_MinMax(t *testing.T) {
 	})
 }
 
+// Ensure a fragment can find the columns with the largest and smallest values.
+func TestFragment_TopK(t *testing.T) {
+	const bitDepth = 16
+
+	f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+	defer f.Close()
+
+	// Set values.
+	for columnID, value := range map[uint64]uint64{
+		1000: 382,
+		2000: 300,
+		3000: 2818,
+		4000: 300,
+		5000: 2818,
+		6000: 2817,
+		7000: 0,
+	} {
+		if _, err := f.setValue(columnID, bitDepth, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		filter  *Row
+		k       uint64
+		bottom  bool
+		columns []uint64
+		values  []uint64
+	}{
+		{k: 3, columns: []uint64{3000, 5000, 6000}, values: []uint64{2818, 2818, 2817}},
+		{k: 1, columns: []uint64{3000}, values: []uint64{2818}},
+		{k: 10, columns: []uint64{3000, 5000, 6000, 1000, 2000, 4000, 7000}, values: []uint64{2818, 2818, 2817, 382, 300, 300, 0}},
+		{k: 2, bottom: true, columns: []uint64{7000, 2000}, values: []uint64{0, 300}},
+		{k: 3, bottom: true, columns: []uint64{7000, 2000, 4000}, values: []uint64{0, 300, 300}},
+		{filter: NewRow(1000, 2000, 5000), k: 2, columns: []uint64{5000, 1000}, values: []uint64{2818, 382}},
+		{filter: NewRow(1000, 2000, 5000), k: 2, bottom: true, columns: []uint64{2000, 1000}, values: []uint64{300, 382}},
+		{filter: NewRow(1), k: 2},
+		{k: 0},
+	}
+	for i, test := range tests {
+		if columns, values, err := f.topK(test.filter, bitDepth, test.k, test.bottom); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(columns, test.columns) {
+			t.Errorf("test %d expected columns: %v, but got: %v", i, test.columns, columns)
+		} else if !reflect.DeepEqual(values, test.values) {
+			t.Errorf("test %d expected values: %v, but got: %v", i, test.values, values)
+		}
+	}
+}
+
+// Ensure a fragment can read the values of many columns at once.
+func TestFragment_Values(t *testing.T) {
+	const bitDepth = 16
+
+	f := mustOpenFragment("i", "f", ViewStandard, 0, "")
+	defer f.Close()
+
+	for columnID, value := range map[uint64]uint64{
+		100: 382,
+		200: 0,
+		300: 2818,
+	} {
+		if _, err := f.setValue(columnID, bitDepth, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	values, exists := f.values([]uint64{50, 100, 200, 250, 300, 400}, bitDepth)
+	if !reflect.DeepEqual(values, []uint64{0, 382, 0, 0, 2818, 0}) {
+		t.Fatalf("unexpected values: %v", values)
+	} else if !reflect.DeepEqual(exists, []bool{false, true, true, false, true, false}) {
+		t.Fatalf("unexpected exists: %v", exists)
+	}
+
+	// Ensure the value matches a single column read.
+	if value, ok, err := f.value(300, bitDepth); err != nil {
+		t.Fatal(err)
+	} else if !ok || value != values[4] {
+		t.Fatalf("unexpected value: %d (%v)", value, ok)
+	}
+}
+
 // Ensure a fragment query for matching values.
 func TestFragment_Range(t *testing.T) {
 	const bitDepth = 16
@@ -591,7 +995,7 @@ func TestFragment_Top(t *testing.T) {
 	f.RecalculateCache()
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{N: 2}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{N: 2}); err != nil {
 		t.Fatal(err)
 	} else if len(pairs) != 2 {
 		t.Fatalf("unexpected count: %d", len(pairs))
@@ -602,6 +1006,47 @@ func TestFragment_Top(t *testing.T) {
 	}
 }
 
+// Ensure retrieving the top rows stops once its context is cancelled.
+func TestFragment_Top_Cancelled(t *testing.T) {
+	f := mustOpenFragment("i", "f", ViewStandard, 0, CacheTypeRanked)
+	defer f.Close()
+	f.mustSetBits(100, 1, 3, 200)
+	f.mustSetBits(101, 1)
+	f.RecalculateCache()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := f.top(ctx, topOptions{N: 2}); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure a fragment can list its rows.
+func TestFragment_Rows(t *testing.T) {
+	f := mustOpenFragment("i", "f", ViewStandard, 0, CacheTypeRanked)
+	defer f.Close()
+
+	// Set bits on rows 1, 3, 70000 & 70001, spanning multiple containers.
+	f.mustSetBits(1, 100, 70000)
+	f.mustSetBits(3, 70000)
+	f.mustSetBits(70000, 5)
+	f.mustSetBits(70001, 100)
+
+	if rows := f.rows(rowsOptions{}); !reflect.DeepEqual(rows, []uint64{1, 3, 70000, 70001}) {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+	if rows := f.rows(rowsOptions{Start: 2, Limit: 2}); !reflect.DeepEqual(rows, []uint64{3, 70000}) {
+		t.Fatalf("unexpected rows with start/limit: %v", rows)
+	}
+	column := uint64(100)
+	if rows := f.rows(rowsOptions{Column: &column}); !reflect.DeepEqual(rows, []uint64{1, 70001}) {
+		t.Fatalf("unexpected rows with column: %v", rows)
+	}
+	if rows := f.rows(rowsOptions{Start: math.MaxUint64/ShardWidth + 1}); len(rows) != 0 {
+		t.Fatalf("unexpected rows past largest row ID: %v", rows)
+	}
+}
+
 // Ensure a fragment can filter rows when retrieving the top n rows.
 func TestFragment_Top_Filter(t *testing.T) {
 	f := mustOpenFragment("i", "f", ViewStandard, 0, CacheTypeRanked)
@@ -617,7 +1062,7 @@ func TestFragment_Top_Filter(t *testing.T) {
 	f.RowAttrStore.SetAttrs(102, map[string]interface{}{"x": int64(20)})
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{
+	if pairs, err := f.top(context.Background(), topOptions{
 		N:            2,
 		FilterName:   "x",
 		FilterValues: []interface{}{int64(10), int64(15), int64(20)},
@@ -648,7 +1093,7 @@ func TestFragment_TopN_Intersect(t *testing.T) {
 	f.RecalculateCache()
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{N: 3, Src: src}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{N: 3, Src: src}); err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(pairs, []Pair{
 		{ID: 101, Count: 3},
@@ -683,7 +1128,7 @@ func TestFragment_TopN_Intersect_Large(t *testing.T) {
 	f.RecalculateCache()
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{N: 10, Src: src}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{N: 10, Src: src}); err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(pairs, []Pair{
 		{ID: 999, Count: 19},
@@ -712,7 +1157,7 @@ func TestFragment_TopN_IDs(t *testing.T) {
 	f.mustSetBits(102, 8, 9, 10, 11, 12)
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{RowIDs: []uint64{100, 101, 200}}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{RowIDs: []uint64{100, 101, 200}}); err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(pairs, []Pair{
 		{ID: 101, Count: 4},
@@ -733,7 +1178,7 @@ func TestFragment_TopN_NopCache(t *testing.T) {
 	f.mustSetBits(102, 8, 9, 10, 11, 12)
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{RowIDs: []uint64{100, 101, 200}}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{RowIDs: []uint64{100, 101, 200}}); err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(pairs, []Pair{}) {
 		t.Fatalf("unexpected pairs: %s", spew.Sdump(pairs))
@@ -792,7 +1237,7 @@ func TestFragment_TopN_CacheSize(t *testing.T) {
 	}
 
 	// Retrieve top rows.
-	if pairs, err := f.top(topOptions{N: 5}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{N: 5}); err != nil {
 		t.Fatal(err)
 	} else if len(pairs) > int(cacheSize) {
 		t.Fatalf("TopN count cannot exceed cache size: %d", cacheSize)
@@ -1081,7 +1526,7 @@ func TestFragment_Tanimoto(t *testing.T) {
 	f.mustSetBits(102, 1, 2, 10, 12)
 	f.RecalculateCache()
 
-	if pairs, err := f.top(topOptions{TanimotoThreshold: 50, Src: src}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{TanimotoThreshold: 50, Src: src}); err != nil {
 		t.Fatal(err)
 	} else if len(pairs) != 2 {
 		t.Fatalf("unexpected count: %d", len(pairs))
@@ -1104,7 +1549,7 @@ func TestFragment_Zero_Tanimoto(t *testing.T) {
 	f.mustSetBits(102, 1, 2, 10, 12)
 	f.RecalculateCache()
 
-	if pairs, err := f.top(topOptions{TanimotoThreshold: 0, Src: src}); err != nil {
+	if pairs, err := f.top(context.Background(), topOptions{TanimotoThreshold: 0, Src: src}); err != nil {
 		t.Fatal(err)
 	} else if len(pairs) != 3 {
 		t.Fatalf("unexpected count: %d", len(pairs))
@@ -2,6 +2,7 @@ package pilosa
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // QueryRequest represent a request to process a query.
@@ -28,6 +29,13 @@ type QueryRequest struct {
 	// If true, indicates that query is part of a larger distributed query.
 	// If false, this request is on the originating node.
 	Remote bool
+
+	// Return the timings of each call, if true.
+	Profile bool
+
+	// Stop the query once it has run this long. Zero uses the server's
+	// default timeout.
+	Timeout time.Duration
 }
 
 // QueryResponse represent a response from a processed query.
@@ -39,6 +47,13 @@ type QueryResponse struct {
 	// Set of column attribute objects matching IDs returned in Result.
 	ColumnAttrSets []*ColumnAttrSet
 
+	// Notes on results which are less precise than requested, such as time
+	// ranges read from views folded by a time rollup.
+	Warnings []string
+
+	// Timings of each top-level call, if profiling was requested.
+	Profile []*CallProfile
+
 	// Error during parsing or execution.
 	Err error
 }
@@ -48,10 +63,14 @@ func (resp *QueryResponse) MarshalJSON() ([]byte, error) {
 	var output struct {
 		Results        []interface{}    `json:"results,omitempty"`
 		ColumnAttrSets []*ColumnAttrSet `json:"columnAttrs,omitempty"`
+		Warnings       []string         `json:"warnings,omitempty"`
+		Profile        []*CallProfile   `json:"profile,omitempty"`
 		Err            string           `json:"error,omitempty"`
 	}
 	output.Results = resp.Results
 	output.ColumnAttrSets = resp.ColumnAttrSets
+	output.Warnings = resp.Warnings
+	output.Profile = resp.Profile
 
 	if resp.Err != nil {
 		output.Err = resp.Err.Error()
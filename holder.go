@@ -215,12 +215,7 @@ func (h *Holder) Schema() []*IndexInfo {
 	for _, index := range h.Indexes() {
 		di := &IndexInfo{Name: index.Name()}
 		for _, field := range index.Fields() {
-			fi := &FieldInfo{Name: field.Name(), Options: field.Options()}
-			for _, view := range field.views() {
-				fi.Views = append(fi.Views, &ViewInfo{Name: view.name})
-			}
-			sort.Sort(viewInfoSlice(fi.Views))
-			di.Fields = append(di.Fields, fi)
+			di.Fields = append(di.Fields, fieldInfo(field))
 		}
 		sort.Sort(fieldInfoSlice(di.Fields))
 		a = append(a, di)
@@ -229,11 +224,23 @@ func (h *Holder) Schema() []*IndexInfo {
 	return a
 }
 
+// fieldInfo returns schema information for a field and its views.
+func fieldInfo(field *Field) *FieldInfo {
+	fi := &FieldInfo{Name: field.Name(), Options: field.Options()}
+	for _, view := range field.views() {
+		fi.Views = append(fi.Views, &ViewInfo{Name: view.name})
+	}
+	sort.Sort(viewInfoSlice(fi.Views))
+	return fi
+}
+
 // applySchema applies an internal Schema to Holder.
 func (h *Holder) applySchema(schema *internal.Schema) error {
 	// Create indexes that don't exist.
 	for _, index := range schema.Indexes {
-		opt := IndexOptions{}
+		opt := IndexOptions{
+			TrackExistence: index.GetMeta().GetTrackExistence(),
+		}
 		idx, err := h.CreateIndexIfNotExists(index.Name, opt)
 		if err != nil {
 			return errors.Wrap(err, "creating index")
@@ -340,6 +347,7 @@ func (h *Holder) createIndex(name string, opt IndexOptions) (*Index, error) {
 	}
 
 	index.keys = opt.Keys
+	index.trackExistence = opt.TrackExistence
 
 	if err := index.Open(); err != nil {
 		return nil, errors.Wrap(err, "opening")
@@ -402,9 +410,19 @@ func (h *Holder) Field(index, name string) *Field {
 	return idx.Field(name)
 }
 
+// field returns the field for an index and name. Unlike Field, it also
+// returns the internal existence field.
+func (h *Holder) field(index, name string) *Field {
+	idx := h.Index(index)
+	if idx == nil {
+		return nil
+	}
+	return idx.internalField(name)
+}
+
 // view returns the view for an index, field, and name.
 func (h *Holder) view(index, field, name string) *View {
-	f := h.Field(index, field)
+	f := h.field(index, field)
 	if f == nil {
 		return nil
 	}
@@ -438,7 +456,7 @@ func (h *Holder) monitorCacheFlush() {
 
 func (h *Holder) flushCaches() {
 	for _, index := range h.Indexes() {
-		for _, field := range index.Fields() {
+		for _, field := range index.allFields() {
 			for _, view := range field.views() {
 				for _, fragment := range view.allFragments() {
 					select {
@@ -605,8 +623,15 @@ func (s *holderSyncer) SyncHolder() error {
 			return fmt.Errorf("index sync error: index=%s, err=%s", di.Name, err)
 		}
 
+		// The existence field is not part of the schema but its fragments
+		// are synced like any other.
+		fields := di.Fields
+		if idx := s.Holder.Index(di.Name); idx != nil && idx.existenceField() != nil {
+			fields = append(fields, fieldInfo(idx.existenceField()))
+		}
+
 		tf := time.Now()
-		for _, fi := range di.Fields {
+		for _, fi := range fields {
 			// Verify syncer has not closed.
 			if s.IsClosing() {
 				return nil
@@ -742,7 +767,7 @@ func (s *holderSyncer) syncField(index, name string) error {
 // syncFragment synchronizes a fragment with the rest of the cluster.
 func (s *holderSyncer) syncFragment(index, field, view string, shard uint64) error {
 	// Retrieve local field.
-	f := s.Holder.Field(index, field)
+	f := s.Holder.field(index, field)
 	if f == nil {
 		return ErrFieldNotFound
 	}
@@ -807,7 +832,7 @@ func (c *holderCleaner) CleanHolder() error {
 		containedShards := c.Cluster.containsShards(index.Name(), index.MaxShard(), c.Node)
 
 		// Get the fragments registered in memory.
-		for _, field := range index.Fields() {
+		for _, field := range index.allFields() {
 			for _, view := range field.views() {
 				for _, fragment := range view.allFragments() {
 					fragShard := fragment.shard
@@ -834,3 +859,94 @@ func uint64InSlice(i uint64, s []uint64) bool {
 	}
 	return false
 }
+
+// holderRetainer removes time views that have aged out of their field's
+// retention and notifies the rest of the cluster.
+type holderRetainer struct {
+	Holder      *Holder
+	Broadcaster broadcaster
+
+	// Signals that the retainer should stop.
+	Closing <-chan struct{}
+}
+
+// IsClosing returns true if the retainer has been marked to close.
+func (r *holderRetainer) IsClosing() bool {
+	select {
+	case <-r.Closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExpireViews deletes every view that is past its field's retention as of
+// now and broadcasts each deletion.
+func (r *holderRetainer) ExpireViews(now time.Time) error {
+	for _, index := range r.Holder.Indexes() {
+		for _, field := range index.Fields() {
+			// Verify retainer has not closed.
+			if r.IsClosing() {
+				return nil
+			}
+
+			for _, name := range field.expiredViews(now) {
+				if err := field.deleteView(name); err != nil && err != ErrInvalidView {
+					return errors.Wrapf(err, "deleting view %s/%s/%s", index.Name(), field.Name(), name)
+				}
+
+				err := r.Broadcaster.SendSync(
+					&internal.DeleteViewMessage{
+						Index: index.Name(),
+						Field: field.Name(),
+						View:  name,
+					})
+				if err != nil {
+					return errors.Wrap(err, "sending DeleteView message")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// holderCompactor deletes the local fragments of time views which have aged
+// past their field's rollup, leaving their bits in larger views. Each node
+// compacts its own fragments, so deletions are not broadcast.
+type holderCompactor struct {
+	Holder *Holder
+	Logger Logger
+
+	// Signals that the compactor should stop.
+	Closing <-chan struct{}
+}
+
+// IsClosing returns true if the compactor has been marked to close.
+func (c *holderCompactor) IsClosing() bool {
+	select {
+	case <-c.Closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// CompactViews deletes every view that is past its field's rollup as of now.
+func (c *holderCompactor) CompactViews(now time.Time) error {
+	for _, index := range c.Holder.Indexes() {
+		for _, field := range index.Fields() {
+			// Verify compactor has not closed.
+			if c.IsClosing() {
+				return nil
+			}
+
+			names, err := field.compactViews(now)
+			if err != nil {
+				return errors.Wrapf(err, "compacting field %s/%s", index.Name(), field.Name())
+			} else if len(names) > 0 && c.Logger != nil {
+				c.Logger.Printf("compacted %d views of field %s/%s", len(names), index.Name(), field.Name())
+			}
+		}
+	}
+	return nil
+}
@@ -21,6 +21,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pilosa/pilosa/internal"
 )
 
 type tHolder struct {
@@ -289,3 +292,53 @@ func TestHolderCleaner_CleanHolder(t *testing.T) {
 		}
 	}
 }
+
+// Ensure the retainer deletes expired views and broadcasts each deletion.
+func TestHolderRetainer_ExpireViews(t *testing.T) {
+	hldr := newHolder()
+	defer hldr.Close()
+
+	idx := hldr.MustCreateIndexIfNotExists("i", IndexOptions{})
+	f, err := idx.CreateField("f", FieldOptions{Type: FieldTypeTime, TimeQuantum: "YMD", TimeRetention: "D=7d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range []string{"2000-01-01 00:00", "2000-01-10 00:00"} {
+		ts := mustParseTime(v)
+		if _, err := f.SetBit(1, uint64(i), &ts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var broadcaster recordingBroadcaster
+	retainer := holderRetainer{
+		Holder:      hldr.Holder,
+		Broadcaster: &broadcaster,
+	}
+	if err := retainer.ExpireViews(mustParseTime("2000-01-12 00:00")); err != nil {
+		t.Fatal(err)
+	}
+
+	if f.view("standard_20000101") != nil {
+		t.Fatal("expected expired view to be deleted")
+	} else if f.view("standard_20000110") == nil || f.view("standard_200001") == nil {
+		t.Fatal("expected unexpired views to remain")
+	}
+	if exp := []proto.Message{&internal.DeleteViewMessage{Index: "i", Field: "f", View: "standard_20000101"}}; !reflect.DeepEqual(broadcaster.msgs, exp) {
+		t.Fatalf("unexpected messages: %v", broadcaster.msgs)
+	}
+}
+
+// recordingBroadcaster is a broadcaster which records the messages sent.
+type recordingBroadcaster struct {
+	msgs []proto.Message
+}
+
+func (b *recordingBroadcaster) SendSync(pb proto.Message) error {
+	b.msgs = append(b.msgs, pb)
+	return nil
+}
+
+func (b *recordingBroadcaster) SendAsync(pb proto.Message) error { return b.SendSync(pb) }
+
+func (b *recordingBroadcaster) SendTo(to *Node, pb proto.Message) error { return b.SendSync(pb) }
@@ -269,6 +269,41 @@ func TestHolder_DeleteIndex(t *testing.T) {
 	}
 }
 
+// Ensure an index's existence tracking survives a holder reopen.
+func TestHolder_CreateIndex_TrackExistence(t *testing.T) {
+	h := test.MustOpenHolder()
+	defer h.Close()
+
+	if _, err := h.CreateIndex("i", pilosa.IndexOptions{TrackExistence: true}); err != nil {
+		t.Fatal(err)
+	} else if err := h.Holder.Close(); err != nil {
+		t.Fatal(err)
+	} else if err := h.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+
+	idx := h.Index("i")
+	if !idx.TrackExistence() {
+		t.Fatal("expected index to track existence")
+	} else if opt := idx.Options(); !opt.TrackExistence {
+		t.Fatalf("unexpected options: %+v", opt)
+	}
+
+	// The existence field is internal and a user's field named "exists" is
+	// an ordinary field.
+	if _, err := idx.CreateField("exists", pilosa.FieldOptions{}); err != nil {
+		t.Fatal(err)
+	} else if idx.Field("_exists") != nil {
+		t.Fatal("expected existence field to be hidden")
+	} else if fields := idx.Fields(); len(fields) != 1 || fields[0].Name() != "exists" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	} else if schema := h.Schema(); len(schema[0].Fields) != 1 || schema[0].Fields[0].Name != "exists" {
+		t.Fatalf("unexpected schema fields: %+v", schema[0].Fields)
+	} else if err := idx.DeleteField("_exists"); err == nil {
+		t.Fatal("expected error deleting existence field")
+	}
+}
+
 // Ensure holder can sync with a remote holder.
 func TestHolderSyncer_SyncHolder(t *testing.T) {
 	c := test.MustNewCluster(t, 2)
@@ -425,14 +425,21 @@ func (c *InternalClient) importNode(ctx context.Context, node *pilosa.Node, inde
 }
 
 // ImportValue bulk imports field values for a single shard to a host.
-func (c *InternalClient) ImportValue(ctx context.Context, index, field string, shard uint64, vals []pilosa.FieldValue) error {
+func (c *InternalClient) ImportValue(ctx context.Context, index, field string, shard uint64, vals []pilosa.FieldValue, opts ...pilosa.ImportOption) error {
 	if index == "" {
 		return pilosa.ErrIndexRequired
 	} else if field == "" {
 		return pilosa.ErrFieldRequired
 	}
 
-	buf, err := marshalImportValuePayload(index, field, shard, vals)
+	var options pilosa.ImportOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return errors.Wrap(err, "applying option")
+		}
+	}
+
+	buf, err := marshalImportValuePayload(index, field, shard, vals, options)
 	if err != nil {
 		return fmt.Errorf("Error Creating Payload: %s", err)
 	}
@@ -454,7 +461,7 @@ func (c *InternalClient) ImportValue(ctx context.Context, index, field string, s
 }
 
 // marshalImportValuePayload marshalls the import parameters into a protobuf byte slice.
-func marshalImportValuePayload(index, field string, shard uint64, vals []pilosa.FieldValue) ([]byte, error) {
+func marshalImportValuePayload(index, field string, shard uint64, vals []pilosa.FieldValue, options pilosa.ImportOptions) ([]byte, error) {
 	// Separate row and column IDs to reduce allocations.
 	columnIDs := FieldValues(vals).ColumnIDs()
 	values := FieldValues(vals).Values()
@@ -466,6 +473,8 @@ func marshalImportValuePayload(index, field string, shard uint64, vals []pilosa.
 		Shard:     shard,
 		ColumnIDs: columnIDs,
 		Values:    values,
+		Clear:     options.Clear,
+		Increment: options.Increment,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal import request: %s", err)
@@ -857,6 +866,60 @@ func (c *InternalClient) SendMessage(ctx context.Context, uri *pilosa.URI, pb pr
 	return nil
 }
 
+// ActiveQueries returns the queries running on a node.
+func (c *InternalClient) ActiveQueries(ctx context.Context, uri *pilosa.URI) ([]pilosa.ActiveQuery, error) {
+	u := uriPathToURL(uri, "/internal/queries")
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("User-Agent", "pilosa/"+pilosa.Version)
+	req.Header.Set("Accept", "application/json")
+
+	// Execute request.
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "executing request")
+	}
+	defer resp.Body.Close()
+
+	var rsp getQueriesResponse
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: code=%d", resp.StatusCode)
+	} else if err := json.NewDecoder(resp.Body).Decode(&rsp); err != nil {
+		return nil, errors.Wrap(err, "decoding")
+	}
+	return rsp.Queries, nil
+}
+
+// CancelQuery cancels a query running on a node. Returns
+// pilosa.ErrQueryNotFound if the node is not running the query.
+func (c *InternalClient) CancelQuery(ctx context.Context, uri *pilosa.URI, id string) error {
+	u := uriPathToURL(uri, "/internal/queries/"+url.PathEscape(id))
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("User-Agent", "pilosa/"+pilosa.Version)
+	req.Header.Set("Accept", "application/json")
+
+	// Execute request.
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "executing request")
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK: // ok
+	case http.StatusNotFound:
+		return pilosa.ErrQueryNotFound
+	default:
+		return fmt.Errorf("unexpected status: code=%d", resp.StatusCode)
+	}
+	return nil
+}
+
 // Bits is a slice of Bit.
 type Bits []pilosa.Bit
 
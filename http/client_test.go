@@ -19,6 +19,7 @@ import (
 	"fmt"
 	gohttp "net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -280,6 +281,100 @@ func TestClient_ImportValue(t *testing.T) {
 	if max != 40 || cnt != 1 {
 		t.Fatalf("unexpected values: got max=%v, count=%v; expected max=40, cnt=1", max, cnt)
 	}
+
+	// Clear values and verify Sum.
+	if err := c.ImportValue(context.Background(), "i", "f", 0, []pilosa.FieldValue{
+		{ColumnID: 1},
+		{ColumnID: 3},
+	}, pilosa.OptImportOptionsClear(true)); err != nil {
+		t.Fatal(err)
+	}
+	if sum, cnt, err := field.Sum(nil, fldName); err != nil {
+		t.Fatal(err)
+	} else if sum != 20 || cnt != 1 {
+		t.Fatalf("unexpected values after clear: got sum=%v, count=%v; expected sum=20, cnt=1", sum, cnt)
+	}
+
+	// Increment values and verify Sum.
+	if err := c.ImportValue(context.Background(), "i", "f", 0, []pilosa.FieldValue{
+		{ColumnID: 1, Value: 5},
+		{ColumnID: 2, Value: -8},
+	}, pilosa.OptImportOptionsIncrement(true)); err != nil {
+		t.Fatal(err)
+	}
+	if sum, cnt, err := field.Sum(nil, fldName); err != nil {
+		t.Fatal(err)
+	} else if sum != 17 || cnt != 2 {
+		t.Fatalf("unexpected values after increment: got sum=%v, count=%v; expected sum=17, cnt=2", sum, cnt)
+	}
+}
+
+// Ensure imported values are scaled into a field with a scale.
+func TestClient_ImportValue_Scale(t *testing.T) {
+	cmd := test.MustRunCluster(t, 1)[0]
+
+	if _, err := cmd.API.CreateIndex(context.Background(), "i", pilosa.IndexOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := cmd.API.CreateField(context.Background(), "i", "price", pilosa.OptFieldTypeDecimal(2, 0, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	c := MustNewClient(cmd.URL(), defaultClient)
+	if err := c.ImportValue(context.Background(), "i", "price", 0, []pilosa.FieldValue{
+		{ColumnID: 1, Value: 3},
+		{ColumnID: 2, Value: 100},
+	}); err != nil {
+		t.Fatal(err)
+	} else if err := c.ImportValue(context.Background(), "i", "price", 0, []pilosa.FieldValue{
+		{ColumnID: 1, Value: 2},
+	}, pilosa.OptImportOptionsIncrement(true)); err != nil {
+		t.Fatal(err)
+	}
+
+	if res, err := cmd.API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Sum(field=price)`}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(res.Results[0], pilosa.ValCount{Val: 105, FloatVal: 105, Count: 2}) {
+		t.Fatalf("unexpected sum: %#v", res.Results[0])
+	}
+
+	// Bounds are checked in the units of the field.
+	if err := c.ImportValue(context.Background(), "i", "price", 0, []pilosa.FieldValue{
+		{ColumnID: 3, Value: 101},
+	}); err == nil || !strings.Contains(err.Error(), pilosa.ErrBSIGroupValueTooHigh.Error()) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure bulk imports mark columns as existing when the index tracks existence.
+func TestClient_Import_TrackExistence(t *testing.T) {
+	cmd := test.MustRunCluster(t, 1)[0]
+	host := cmd.URL()
+	hldr := test.Holder{Holder: cmd.Server.Holder()}
+
+	index := hldr.MustCreateIndexIfNotExists("i", pilosa.IndexOptions{TrackExistence: true})
+	if _, err := index.CreateFieldIfNotExists("f", pilosa.FieldOptions{}); err != nil {
+		t.Fatal(err)
+	} else if _, err := index.CreateFieldIfNotExists("v", pilosa.FieldOptions{Type: pilosa.FieldTypeInt, Min: 0, Max: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := MustNewClient(host, defaultClient)
+	if err := c.Import(context.Background(), "i", "f", 0, []pilosa.Bit{
+		{RowID: 0, ColumnID: 1},
+		{RowID: 200, ColumnID: 6},
+	}); err != nil {
+		t.Fatal(err)
+	} else if err := c.ImportValue(context.Background(), "i", "v", 0, []pilosa.FieldValue{
+		{ColumnID: 3, Value: 40},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if res, err := cmd.API.Query(context.Background(), &pilosa.QueryRequest{Index: "i", Query: `Not(Row(f=100))`}); err != nil {
+		t.Fatal(err)
+	} else if a := res.Results[0].(*pilosa.Row).Columns(); !reflect.DeepEqual(a, []uint64{1, 3, 6}) {
+		t.Fatalf("unexpected columns: %+v", a)
+	}
 }
 
 // Ensure client can retrieve a list of all checksums for blocks in a fragment.
@@ -71,6 +71,7 @@ var externalPrefixFlag = map[string]bool{
 	"field":   true,
 	"nodes":   true,
 	"version": true,
+	"queries": true,
 }
 
 type errorResponse struct {
@@ -158,7 +159,7 @@ func (h *Handler) populateValidators() {
 	h.validators = map[string]*queryValidationSpec{}
 	h.validators["GetFragmentNodes"] = queryValidationSpecRequired("shard", "index")
 	h.validators["GetShardMax"] = queryValidationSpecRequired()
-	h.validators["PostQuery"] = queryValidationSpecRequired().Optional("shards", "columnAttrs", "excludeRowAttrs", "excludeColumns")
+	h.validators["PostQuery"] = queryValidationSpecRequired().Optional("shards", "columnAttrs", "excludeRowAttrs", "excludeColumns", "profile", "timeout")
 	h.validators["GetExport"] = queryValidationSpecRequired("index", "field", "shard")
 	h.validators["GetFragmentData"] = queryValidationSpecRequired("index", "field", "shard")
 	h.validators["PostFragmentData"] = queryValidationSpecRequired("index", "field", "shard")
@@ -205,6 +206,8 @@ func NewRouter(handler *Handler) *mux.Router {
 	router.HandleFunc("/index/{index}/field/{field}/import", handler.handlePostImport).Methods("POST")
 	router.HandleFunc("/index/{index}/query", handler.handlePostQuery).Methods("POST").Name("PostQuery")
 	router.HandleFunc("/info", handler.handleGetInfo).Methods("GET")
+	router.HandleFunc("/queries", handler.handleGetQueries).Methods("GET")
+	router.HandleFunc("/queries/{id}", handler.handleDeleteQuery).Methods("DELETE")
 	router.HandleFunc("/recalculate-caches", handler.handleRecalculateCaches).Methods("POST")
 	router.HandleFunc("/schema", handler.handleGetSchema).Methods("GET")
 	router.HandleFunc("/status", handler.handleGetStatus).Methods("GET")
@@ -218,6 +221,8 @@ func NewRouter(handler *Handler) *mux.Router {
 	router.HandleFunc("/internal/fragment/nodes", handler.handleGetFragmentNodes).Methods("GET").Name("GetFragmentNodes")
 	router.HandleFunc("/internal/index/{index}/attr/diff", handler.handlePostIndexAttrDiff).Methods("POST")
 	router.HandleFunc("/internal/index/{index}/field/{field}/attr/diff", handler.handlePostFieldAttrDiff).Methods("POST")
+	router.HandleFunc("/internal/queries", handler.handleGetLocalQueries).Methods("GET")
+	router.HandleFunc("/internal/queries/{id}", handler.handleDeleteLocalQuery).Methods("DELETE")
 	router.HandleFunc("/internal/shards/max", handler.handleGetShardsMax).Methods("GET") // TODO: deprecate, but it's being used by the client
 	router.HandleFunc("/internal/translate/data", handler.handleGetTranslateData).Methods("GET")
 
@@ -416,7 +421,12 @@ func (h *Handler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.API.Query(r.Context(), req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		switch errors.Cause(err) {
+		case pilosa.ErrQueryTimeout:
+			w.WriteHeader(http.StatusRequestTimeout)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
 		h.writeQueryResponse(w, r, &pilosa.QueryResponse{Err: err})
 		return
 	}
@@ -437,6 +447,66 @@ func (h *Handler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleGetQueries handles GET /queries requests.
+func (h *Handler) handleGetQueries(w http.ResponseWriter, r *http.Request) {
+	if !validHeaderAcceptJSON(r.Header) {
+		http.Error(w, "JSON only acceptable response", http.StatusNotAcceptable)
+		return
+	}
+	queries, nodeErrs := h.API.ActiveQueries(r.Context())
+	resp := getQueriesResponse{Queries: queries}
+	for id, err := range nodeErrs {
+		if resp.Errors == nil {
+			resp.Errors = make(map[string]string)
+		}
+		resp.Errors[id] = err.Error()
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.Logger.Printf("write queries response error: %s", err)
+	}
+}
+
+// handleGetLocalQueries handles GET /internal/queries requests.
+func (h *Handler) handleGetLocalQueries(w http.ResponseWriter, r *http.Request) {
+	if !validHeaderAcceptJSON(r.Header) {
+		http.Error(w, "JSON only acceptable response", http.StatusNotAcceptable)
+		return
+	}
+	queries := h.API.LocalActiveQueries(r.Context())
+	if err := json.NewEncoder(w).Encode(getQueriesResponse{Queries: queries}); err != nil {
+		h.Logger.Printf("write queries response error: %s", err)
+	}
+}
+
+type getQueriesResponse struct {
+	Queries []pilosa.ActiveQuery `json:"queries"`
+
+	// Errors of the nodes whose queries could not be listed, by node ID.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// handleDeleteQuery handles DELETE /queries/<id> requests.
+func (h *Handler) handleDeleteQuery(w http.ResponseWriter, r *http.Request) {
+	if !validHeaderAcceptJSON(r.Header) {
+		http.Error(w, "JSON only acceptable response", http.StatusNotAcceptable)
+		return
+	}
+	resp := successResponse{}
+	err := h.API.CancelQuery(r.Context(), mux.Vars(r)["id"])
+	resp.write(w, err)
+}
+
+// handleDeleteLocalQuery handles DELETE /internal/queries/<id> requests.
+func (h *Handler) handleDeleteLocalQuery(w http.ResponseWriter, r *http.Request) {
+	if !validHeaderAcceptJSON(r.Header) {
+		http.Error(w, "JSON only acceptable response", http.StatusNotAcceptable)
+		return
+	}
+	resp := successResponse{}
+	err := h.API.CancelLocalQuery(r.Context(), mux.Vars(r)["id"])
+	resp.write(w, err)
+}
+
 // handleGetShardsMax handles GET /internal/shards/max requests.
 func (h *Handler) handleGetShardsMax(w http.ResponseWriter, r *http.Request) {
 	if !validHeaderAcceptJSON(r.Header) {
@@ -660,12 +730,35 @@ func (h *Handler) handlePostField(w http.ResponseWriter, r *http.Request) {
 	case pilosa.FieldTypeSet:
 		fos = pilosa.OptFieldTypeSet(*req.Options.CacheType, *req.Options.CacheSize)
 	case pilosa.FieldTypeInt:
-		fos = pilosa.OptFieldTypeInt(*req.Options.Min, *req.Options.Max)
+		if req.Options.Scale != nil {
+			fos = pilosa.OptFieldTypeDecimal(*req.Options.Scale, *req.Options.Min, *req.Options.Max)
+		} else {
+			fos = pilosa.OptFieldTypeInt(*req.Options.Min, *req.Options.Max)
+		}
 	case pilosa.FieldTypeTime:
-		fos = pilosa.OptFieldTypeTime(*req.Options.TimeQuantum)
+		if req.Options.TimeZone != nil {
+			fos = pilosa.OptFieldTypeTimeZone(*req.Options.TimeQuantum, *req.Options.TimeZone)
+		} else {
+			fos = pilosa.OptFieldTypeTime(*req.Options.TimeQuantum)
+		}
+	case pilosa.FieldTypeMutex:
+		fos = pilosa.OptFieldTypeMutex(*req.Options.CacheType, *req.Options.CacheSize)
+	case pilosa.FieldTypeBool:
+		fos = pilosa.OptFieldTypeBool()
+	}
+
+	opts := []pilosa.FieldOption{fos}
+	if req.Options.NoStandardView != nil && *req.Options.NoStandardView {
+		opts = append(opts, pilosa.OptFieldNoStandardView())
+	}
+	if req.Options.TimeRetention != nil {
+		opts = append(opts, pilosa.OptFieldTimeRetention(*req.Options.TimeRetention))
+	}
+	if req.Options.TimeRollup != nil {
+		opts = append(opts, pilosa.OptFieldTimeRollup(*req.Options.TimeRollup))
 	}
 
-	_, err = h.API.CreateField(r.Context(), indexName, fieldName, fos)
+	_, err = h.API.CreateField(r.Context(), indexName, fieldName, opts...)
 	resp.write(w, err)
 }
 
@@ -676,13 +769,18 @@ type postFieldRequest struct {
 // fieldOptions tracks pilosa.FieldOptions. It is made up of pointers to values,
 // and used for input validation.
 type fieldOptions struct {
-	Type        string              `json:"type,omitempty"`
-	CacheType   *string             `json:"cacheType,omitempty"`
-	CacheSize   *uint32             `json:"cacheSize,omitempty"`
-	Min         *int64              `json:"min,omitempty"`
-	Max         *int64              `json:"max,omitempty"`
-	TimeQuantum *pilosa.TimeQuantum `json:"timeQuantum,omitempty"`
-	Keys        *bool               `json:"keys,omitempty"`
+	Type           string                `json:"type,omitempty"`
+	CacheType      *string               `json:"cacheType,omitempty"`
+	CacheSize      *uint32               `json:"cacheSize,omitempty"`
+	Min            *int64                `json:"min,omitempty"`
+	Max            *int64                `json:"max,omitempty"`
+	Scale          *int64                `json:"scale,omitempty"`
+	TimeQuantum    *pilosa.TimeQuantum   `json:"timeQuantum,omitempty"`
+	TimeZone       *string               `json:"timeZone,omitempty"`
+	NoStandardView *bool                 `json:"noStandardView,omitempty"`
+	TimeRetention  *pilosa.TimeRetention `json:"timeRetention,omitempty"`
+	TimeRollup     *pilosa.TimeRollup    `json:"timeRollup,omitempty"`
+	Keys           *bool                 `json:"keys,omitempty"`
 }
 
 func (o *fieldOptions) validate() error {
@@ -708,8 +806,18 @@ func (o *fieldOptions) validate() error {
 			return pilosa.NewBadRequestError(errors.New("min does not apply to field type set"))
 		} else if o.Max != nil {
 			return pilosa.NewBadRequestError(errors.New("max does not apply to field type set"))
+		} else if o.Scale != nil {
+			return pilosa.NewBadRequestError(errors.New("scale does not apply to field type set"))
 		} else if o.TimeQuantum != nil {
 			return pilosa.NewBadRequestError(errors.New("timeQuantum does not apply to field type set"))
+		} else if o.TimeZone != nil {
+			return pilosa.NewBadRequestError(errors.New("timeZone does not apply to field type set"))
+		} else if o.NoStandardView != nil {
+			return pilosa.NewBadRequestError(errors.New("noStandardView does not apply to field type set"))
+		} else if o.TimeRetention != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRetention does not apply to field type set"))
+		} else if o.TimeRollup != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRollup does not apply to field type set"))
 		}
 	case pilosa.FieldTypeInt:
 		if o.CacheType != nil {
@@ -722,6 +830,14 @@ func (o *fieldOptions) validate() error {
 			return pilosa.NewBadRequestError(errors.New("max is required for field type int"))
 		} else if o.TimeQuantum != nil {
 			return pilosa.NewBadRequestError(errors.New("timeQuantum does not apply to field type int"))
+		} else if o.TimeZone != nil {
+			return pilosa.NewBadRequestError(errors.New("timeZone does not apply to field type int"))
+		} else if o.NoStandardView != nil {
+			return pilosa.NewBadRequestError(errors.New("noStandardView does not apply to field type int"))
+		} else if o.TimeRetention != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRetention does not apply to field type int"))
+		} else if o.TimeRollup != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRollup does not apply to field type int"))
 		}
 	case pilosa.FieldTypeTime:
 		if o.CacheType != nil {
@@ -732,9 +848,69 @@ func (o *fieldOptions) validate() error {
 			return pilosa.NewBadRequestError(errors.New("min does not apply to field type time"))
 		} else if o.Max != nil {
 			return pilosa.NewBadRequestError(errors.New("max does not apply to field type time"))
+		} else if o.Scale != nil {
+			return pilosa.NewBadRequestError(errors.New("scale does not apply to field type time"))
 		} else if o.TimeQuantum == nil {
 			return pilosa.NewBadRequestError(errors.New("timeQuantum is required for field type time"))
 		}
+		if o.TimeRetention != nil {
+			if _, err := pilosa.ParseTimeRetention(string(*o.TimeRetention)); err != nil {
+				return pilosa.NewBadRequestError(err)
+			}
+		}
+		if o.TimeRollup != nil {
+			if _, err := pilosa.ParseTimeRollup(string(*o.TimeRollup)); err != nil {
+				return pilosa.NewBadRequestError(err)
+			}
+		}
+	case pilosa.FieldTypeMutex:
+		if o.CacheType == nil {
+			o.CacheType = &defaultCacheType
+		}
+		if o.CacheSize == nil {
+			o.CacheSize = &defaultCacheSize
+		}
+		if o.Min != nil {
+			return pilosa.NewBadRequestError(errors.New("min does not apply to field type mutex"))
+		} else if o.Max != nil {
+			return pilosa.NewBadRequestError(errors.New("max does not apply to field type mutex"))
+		} else if o.Scale != nil {
+			return pilosa.NewBadRequestError(errors.New("scale does not apply to field type mutex"))
+		} else if o.TimeQuantum != nil {
+			return pilosa.NewBadRequestError(errors.New("timeQuantum does not apply to field type mutex"))
+		} else if o.TimeZone != nil {
+			return pilosa.NewBadRequestError(errors.New("timeZone does not apply to field type mutex"))
+		} else if o.NoStandardView != nil {
+			return pilosa.NewBadRequestError(errors.New("noStandardView does not apply to field type mutex"))
+		} else if o.TimeRetention != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRetention does not apply to field type mutex"))
+		} else if o.TimeRollup != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRollup does not apply to field type mutex"))
+		}
+	case pilosa.FieldTypeBool:
+		if o.CacheType != nil {
+			return pilosa.NewBadRequestError(errors.New("cacheType does not apply to field type bool"))
+		} else if o.CacheSize != nil {
+			return pilosa.NewBadRequestError(errors.New("cacheSize does not apply to field type bool"))
+		} else if o.Min != nil {
+			return pilosa.NewBadRequestError(errors.New("min does not apply to field type bool"))
+		} else if o.Max != nil {
+			return pilosa.NewBadRequestError(errors.New("max does not apply to field type bool"))
+		} else if o.Scale != nil {
+			return pilosa.NewBadRequestError(errors.New("scale does not apply to field type bool"))
+		} else if o.TimeQuantum != nil {
+			return pilosa.NewBadRequestError(errors.New("timeQuantum does not apply to field type bool"))
+		} else if o.TimeZone != nil {
+			return pilosa.NewBadRequestError(errors.New("timeZone does not apply to field type bool"))
+		} else if o.NoStandardView != nil {
+			return pilosa.NewBadRequestError(errors.New("noStandardView does not apply to field type bool"))
+		} else if o.TimeRetention != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRetention does not apply to field type bool"))
+		} else if o.TimeRollup != nil {
+			return pilosa.NewBadRequestError(errors.New("timeRollup does not apply to field type bool"))
+		} else if o.Keys != nil && *o.Keys {
+			return pilosa.NewBadRequestError(errors.New("keys does not apply to field type bool"))
+		}
 	default:
 		return errors.Errorf("invalid field type: %s", o.Type)
 	}
@@ -843,12 +1019,22 @@ func (h *Handler) readURLQueryRequest(r *http.Request) (*pilosa.QueryRequest, er
 		return nil, errors.New("invalid shard argument")
 	}
 
+	// Parse timeout, if set.
+	var timeout time.Duration
+	if s := q.Get("timeout"); s != "" {
+		if timeout, err = time.ParseDuration(s); err != nil || timeout < 0 {
+			return nil, errors.New("invalid timeout argument")
+		}
+	}
+
 	return &pilosa.QueryRequest{
 		Query:           query,
 		Shards:          shards,
 		ColumnAttrs:     q.Get("columnAttrs") == "true",
 		ExcludeRowAttrs: q.Get("excludeRowAttrs") == "true",
 		ExcludeColumns:  q.Get("excludeColumns") == "true",
+		Profile:         q.Get("profile") == "true",
+		Timeout:         timeout,
 	}, nil
 }
 
@@ -1103,6 +1289,11 @@ const (
 	QueryResultTypeValCount
 	QueryResultTypeUint64
 	QueryResultTypeBool
+	QueryResultTypeRowIdentifiers
+	QueryResultTypeGroupCounts
+	QueryResultTypeColumnValues
+	QueryResultTypeExtractedTable
+	QueryResultTypeTimeCounts
 )
 
 func decodeQueryRequest(pb *internal.QueryRequest) *pilosa.QueryRequest {
@@ -1113,6 +1304,8 @@ func decodeQueryRequest(pb *internal.QueryRequest) *pilosa.QueryRequest {
 		Remote:          pb.Remote,
 		ExcludeRowAttrs: pb.ExcludeRowAttrs,
 		ExcludeColumns:  pb.ExcludeColumns,
+		Profile:         pb.Profile,
+		Timeout:         time.Duration(pb.Timeout),
 	}
 
 	return req
@@ -1122,6 +1315,8 @@ func encodeQueryResponse(resp *pilosa.QueryResponse) *internal.QueryResponse {
 	pb := &internal.QueryResponse{
 		Results:        make([]*internal.QueryResult, len(resp.Results)),
 		ColumnAttrSets: pilosa.EncodeColumnAttrSets(resp.ColumnAttrSets),
+		Warnings:       resp.Warnings,
+		Profile:        pilosa.EncodeCallProfiles(resp.Profile),
 	}
 
 	for i := range resp.Results {
@@ -1143,6 +1338,21 @@ func encodeQueryResponse(resp *pilosa.QueryResponse) *internal.QueryResponse {
 		case bool:
 			pb.Results[i].Type = QueryResultTypeBool
 			pb.Results[i].Changed = result
+		case pilosa.RowIdentifiers:
+			pb.Results[i].Type = QueryResultTypeRowIdentifiers
+			pb.Results[i].RowIdentifiers = pilosa.EncodeRowIdentifiers(result)
+		case []pilosa.GroupCount:
+			pb.Results[i].Type = QueryResultTypeGroupCounts
+			pb.Results[i].GroupCounts = pilosa.EncodeGroupCounts(result)
+		case []pilosa.ColumnValue:
+			pb.Results[i].Type = QueryResultTypeColumnValues
+			pb.Results[i].ColumnValues = pilosa.EncodeColumnValues(result)
+		case pilosa.ExtractedTable:
+			pb.Results[i].Type = QueryResultTypeExtractedTable
+			pb.Results[i].ExtractedTable = pilosa.EncodeExtractedTable(result)
+		case []pilosa.TimeCount:
+			pb.Results[i].Type = QueryResultTypeTimeCounts
+			pb.Results[i].TimeCounts = pilosa.EncodeTimeCounts(result)
 		case nil:
 			pb.Results[i].Type = QueryResultTypeNil
 		}
@@ -102,9 +102,16 @@ func int64Ptr(i int64) *int64 {
 	return &i
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 // Test fieldOption validation.
 func TestFieldOptionValidation(t *testing.T) {
 	timeQuantum := pilosa.TimeQuantum("YMD")
+	timeQuantumH := pilosa.TimeQuantum("YMDH")
+	timeRetention := pilosa.TimeRetention("D=90d")
+	timeRollup := pilosa.TimeRollup("H=30d")
 	defaultCacheSize := uint32(pilosa.DefaultCacheSize)
 	tests := []struct {
 		json     string
@@ -130,6 +137,11 @@ func TestFieldOptionValidation(t *testing.T) {
 		{json: `{"options": {"type": "set", "min": 0}}`, err: "min does not apply to field type set"},
 		{json: `{"options": {"type": "set", "max": 100}}`, err: "max does not apply to field type set"},
 		{json: `{"options": {"type": "set", "timeQuantum": "YMD"}}`, err: "timeQuantum does not apply to field type set"},
+		{json: `{"options": {"type": "set", "scale": 2}}`, err: "scale does not apply to field type set"},
+		{json: `{"options": {"type": "set", "timeZone": "UTC"}}`, err: "timeZone does not apply to field type set"},
+		{json: `{"options": {"type": "set", "noStandardView": true}}`, err: "noStandardView does not apply to field type set"},
+		{json: `{"options": {"type": "set", "timeRetention": "D=90d"}}`, err: "timeRetention does not apply to field type set"},
+		{json: `{"options": {"type": "set", "timeRollup": "H=30d"}}`, err: "timeRollup does not apply to field type set"},
 
 		// FieldType: Int
 		{json: `{"options": {"type": "int"}}`, err: "min is required for field type int"},
@@ -142,6 +154,16 @@ func TestFieldOptionValidation(t *testing.T) {
 		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "cacheType": "ranked"}}`, err: "cacheType does not apply to field type int"},
 		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "cacheSize": 1000}}`, err: "cacheSize does not apply to field type int"},
 		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "timeQuantum": "YMD"}}`, err: "timeQuantum does not apply to field type int"},
+		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "timeZone": "UTC"}}`, err: "timeZone does not apply to field type int"},
+		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "noStandardView": true}}`, err: "noStandardView does not apply to field type int"},
+		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "timeRetention": "D=90d"}}`, err: "timeRetention does not apply to field type int"},
+		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "timeRollup": "H=30d"}}`, err: "timeRollup does not apply to field type int"},
+		{json: `{"options": {"type": "int", "min": 0, "max": 1000, "scale": 2}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:  pilosa.FieldTypeInt,
+			Min:   int64Ptr(0),
+			Max:   int64Ptr(1000),
+			Scale: int64Ptr(2),
+		}}},
 
 		// FieldType: Time
 		{json: `{"options": {"type": "time"}}`, err: "timeQuantum is required for field type time"},
@@ -149,10 +171,65 @@ func TestFieldOptionValidation(t *testing.T) {
 			Type:        pilosa.FieldTypeTime,
 			TimeQuantum: &timeQuantum,
 		}}},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "timeZone": "Europe/Paris"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:        pilosa.FieldTypeTime,
+			TimeQuantum: &timeQuantum,
+			TimeZone:    stringPtr("Europe/Paris"),
+		}}},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "noStandardView": true}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:           pilosa.FieldTypeTime,
+			TimeQuantum:    &timeQuantum,
+			NoStandardView: boolPtr(true),
+		}}},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "timeRetention": "D=90d"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:          pilosa.FieldTypeTime,
+			TimeQuantum:   &timeQuantum,
+			TimeRetention: &timeRetention,
+		}}},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "timeRetention": "D=forever"}}`, err: "invalid time retention"},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMDH", "timeRollup": "H=30d"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:        pilosa.FieldTypeTime,
+			TimeQuantum: &timeQuantumH,
+			TimeRollup:  &timeRollup,
+		}}},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMDH", "timeRollup": "Y=1y"}}`, err: "invalid time rollup"},
 		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "min": 0}}`, err: "min does not apply to field type time"},
 		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "max": 1000}}`, err: "max does not apply to field type time"},
+		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "scale": 2}}`, err: "scale does not apply to field type time"},
 		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "cacheType": "ranked"}}`, err: "cacheType does not apply to field type time"},
 		{json: `{"options": {"type": "time", "timeQuantum": "YMD", "cacheSize": 1000}}`, err: "cacheSize does not apply to field type time"},
+
+		// FieldType: Mutex
+		{json: `{"options": {"type": "mutex"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:      pilosa.FieldTypeMutex,
+			CacheType: stringPtr(pilosa.DefaultCacheType),
+			CacheSize: &defaultCacheSize,
+		}}},
+		{json: `{"options": {"type": "mutex", "cacheType": "lru"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type:      pilosa.FieldTypeMutex,
+			CacheType: stringPtr("lru"),
+			CacheSize: &defaultCacheSize,
+		}}},
+		{json: `{"options": {"type": "mutex", "min": 0}}`, err: "min does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "max": 100}}`, err: "max does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "scale": 2}}`, err: "scale does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "timeQuantum": "YMD"}}`, err: "timeQuantum does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "timeZone": "UTC"}}`, err: "timeZone does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "noStandardView": true}}`, err: "noStandardView does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "timeRetention": "D=90d"}}`, err: "timeRetention does not apply to field type mutex"},
+		{json: `{"options": {"type": "mutex", "timeRollup": "H=30d"}}`, err: "timeRollup does not apply to field type mutex"},
+
+		// FieldType: Bool
+		{json: `{"options": {"type": "bool"}}`, expected: postFieldRequest{Options: fieldOptions{
+			Type: pilosa.FieldTypeBool,
+		}}},
+		{json: `{"options": {"type": "bool", "cacheType": "ranked"}}`, err: "cacheType does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "min": 0}}`, err: "min does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "timeZone": "UTC"}}`, err: "timeZone does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "noStandardView": true}}`, err: "noStandardView does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "timeRetention": "D=90d"}}`, err: "timeRetention does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "timeRollup": "H=30d"}}`, err: "timeRollup does not apply to field type bool"},
+		{json: `{"options": {"type": "bool", "keys": true}}`, err: "keys does not apply to field type bool"},
 	}
 	for i, test := range tests {
 		actual := &postFieldRequest{}
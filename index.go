@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +29,11 @@ import (
 	"github.com/pkg/errors"
 )
 
+// existenceFieldName is the name of the internal field used to track which
+// columns exist in an index. It is not a valid field name so it cannot clash
+// with a user's field.
+const existenceFieldName = "_exists"
+
 // Index represents a container for fields.
 type Index struct {
 	mu   sync.RWMutex
@@ -35,6 +41,10 @@ type Index struct {
 	name string
 	keys bool // use string keys
 
+	// Tracks which columns have been set in the index.
+	trackExistence bool
+	existenceFld   *Field
+
 	// Fields by name.
 	fields map[string]*Field
 
@@ -84,6 +94,13 @@ func (i *Index) Path() string { return i.path }
 // Keys returns true if the index uses string keys.
 func (i *Index) Keys() bool { return i.keys }
 
+// TrackExistence returns true if the index tracks which columns exist.
+func (i *Index) TrackExistence() bool { return i.trackExistence }
+
+// existenceField returns the internal field used for tracking column
+// existence. Returns nil if existence tracking is disabled.
+func (i *Index) existenceField() *Field { return i.existenceFld }
+
 // ColumnAttrStore returns the storage for column attributes.
 func (i *Index) ColumnAttrStore() AttrStore { return i.columnAttrStore }
 
@@ -95,7 +112,10 @@ func (i *Index) Options() IndexOptions {
 }
 
 func (i *Index) options() IndexOptions {
-	return IndexOptions{}
+	return IndexOptions{
+		Keys:           i.keys,
+		TrackExistence: i.trackExistence,
+	}
 }
 
 // Open opens and initializes the index.
@@ -114,6 +134,12 @@ func (i *Index) Open() error {
 		return errors.Wrap(err, "opening fields")
 	}
 
+	if i.trackExistence {
+		if err := i.openExistenceField(); err != nil {
+			return errors.Wrap(err, "opening existence field")
+		}
+	}
+
 	if err := i.columnAttrStore.Open(); err != nil {
 		return errors.Wrap(err, "opening attrstore")
 	}
@@ -139,6 +165,11 @@ func (i *Index) openFields() error {
 			continue
 		}
 
+		// The existence field is opened separately.
+		if filepath.Base(fi.Name()) == existenceFieldName {
+			continue
+		}
+
 		fld, err := i.newField(i.FieldPath(filepath.Base(fi.Name())), filepath.Base(fi.Name()))
 		if err != nil {
 			return ErrName
@@ -151,6 +182,66 @@ func (i *Index) openFields() error {
 	return nil
 }
 
+// openExistenceField opens the internal existence field, creating it if
+// it does not exist yet. The field is kept out of the index's field lookup so
+// that it is not listed, written or deleted like a user's field.
+func (i *Index) openExistenceField() error {
+	path := i.FieldPath(existenceFieldName)
+	_, err := os.Stat(path)
+	created := os.IsNotExist(err)
+
+	f := i.initField(newField(path, i.name, existenceFieldName, FieldOptions{}))
+	if err := f.Open(); err != nil {
+		return errors.Wrap(err, "opening")
+	}
+
+	if created {
+		if err := f.applyOptions(FieldOptions{CacheType: CacheTypeNone, CacheSize: 0}); err != nil {
+			f.Close()
+			return errors.Wrap(err, "applying options")
+		} else if err := f.saveMeta(); err != nil {
+			f.Close()
+			return errors.Wrap(err, "saving meta")
+		} else if err := i.backfillExistence(f); err != nil {
+			f.Close()
+			return errors.Wrap(err, "backfilling")
+		}
+	}
+
+	i.existenceFld = f
+	return nil
+}
+
+// backfillExistence marks every column already set in the index's fields as
+// existing in ef. Int fields only count columns which have a value.
+func (i *Index) backfillExistence(ef *Field) error {
+	for _, f := range i.fields {
+		bsig := f.bsiGroup(f.name)
+		for _, view := range f.views() {
+			for _, frag := range view.allFragments() {
+				var row *Row
+				if bsig != nil && strings.HasPrefix(view.name, viewBSIGroupPrefix) {
+					row = frag.row(uint64(bsig.BitDepth()))
+				} else {
+					row = NewRow()
+					for _, rowID := range frag.rows(rowsOptions{}) {
+						row = row.Union(frag.row(rowID))
+					}
+				}
+
+				columnIDs := row.Columns()
+				if len(columnIDs) == 0 {
+					continue
+				}
+				if err := ef.Import(make([]uint64, len(columnIDs)), columnIDs, nil); err != nil {
+					return errors.Wrapf(err, "importing columns of %s/%s", f.name, view.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // loadMeta reads meta data for the index, if any.
 func (i *Index) loadMeta() error {
 	var pb internal.IndexMeta
@@ -169,6 +260,7 @@ func (i *Index) loadMeta() error {
 
 	// Copy metadata fields.
 	i.keys = pb.Keys
+	i.trackExistence = pb.TrackExistence
 
 	return nil
 }
@@ -177,7 +269,8 @@ func (i *Index) loadMeta() error {
 func (i *Index) saveMeta() error {
 	// Marshal metadata.
 	buf, err := proto.Marshal(&internal.IndexMeta{
-		Keys: i.keys,
+		Keys:           i.keys,
+		TrackExistence: i.trackExistence,
 	})
 	if err != nil {
 		return errors.Wrap(err, "marshalling")
@@ -207,6 +300,14 @@ func (i *Index) Close() error {
 	}
 	i.fields = make(map[string]*Field)
 
+	// Close the existence field.
+	if i.existenceFld != nil {
+		if err := i.existenceFld.Close(); err != nil {
+			return errors.Wrap(err, "closing existence field")
+		}
+		i.existenceFld = nil
+	}
+
 	return nil
 }
 
@@ -248,6 +349,15 @@ func (i *Index) Field(name string) *Field {
 
 func (i *Index) field(name string) *Field { return i.fields[name] }
 
+// internalField returns a field by name. Unlike Field, it also returns the
+// internal existence field.
+func (i *Index) internalField(name string) *Field {
+	if name == existenceFieldName {
+		return i.existenceField()
+	}
+	return i.Field(name)
+}
+
 // Fields returns a list of all fields in the index.
 func (i *Index) Fields() []*Field {
 	i.mu.RLock()
@@ -262,6 +372,16 @@ func (i *Index) Fields() []*Field {
 	return a
 }
 
+// allFields returns a list of all fields in the index, including the internal
+// existence field.
+func (i *Index) allFields() []*Field {
+	a := i.Fields()
+	if ef := i.existenceField(); ef != nil {
+		a = append(a, ef)
+	}
+	return a
+}
+
 // RecalculateCaches recalculates caches on every field in the index.
 func (i *Index) RecalculateCaches() {
 	for _, field := range i.Fields() {
@@ -334,11 +454,16 @@ func (i *Index) newField(path, name string) (*Field, error) {
 	if err != nil {
 		return nil, err
 	}
+	return i.initField(f), nil
+}
+
+// initField sets up f to log, report stats and broadcast through the index.
+func (i *Index) initField(f *Field) *Field {
 	f.Logger = i.Logger
-	f.Stats = i.Stats.WithTags(fmt.Sprintf("field:%s", name))
+	f.Stats = i.Stats.WithTags(fmt.Sprintf("field:%s", f.name))
 	f.broadcaster = i.broadcaster
 	f.rowAttrStore = i.NewAttrStore(filepath.Join(f.path, ".data"))
-	return f, nil
+	return f
 }
 
 // DeleteField removes a field from the index.
@@ -399,19 +524,22 @@ func EncodeIndexes(a []*Index) []*internal.Index {
 func encodeIndex(d *Index) *internal.Index {
 	return &internal.Index{
 		Name:   d.name,
+		Meta:   &internal.IndexMeta{TrackExistence: d.trackExistence},
 		Fields: encodeFields(d.Fields()),
 	}
 }
 
 // IndexOptions represents options to set when initializing an index.
 type IndexOptions struct {
-	Keys bool `json:"keys"`
+	Keys           bool `json:"keys"`
+	TrackExistence bool `json:"trackExistence"`
 }
 
 // Encode converts i into its internal representation.
 func (i *IndexOptions) Encode() *internal.IndexMeta {
 	return &internal.IndexMeta{
-		Keys: i.Keys,
+		Keys:           i.Keys,
+		TrackExistence: i.TrackExistence,
 	}
 }
 
@@ -16,6 +16,8 @@ package pilosa
 
 import (
 	"io/ioutil"
+	"reflect"
+	"testing"
 )
 
 // mustOpenIndex returns a new, opened index at a temporary path. Panic on error.
@@ -44,3 +46,39 @@ func (i *Index) reopen() error {
 	}
 	return nil
 }
+
+// Ensure columns set before the existence field was created are backfilled.
+func TestIndex_OpenExistenceField_Backfill(t *testing.T) {
+	index := mustOpenIndex()
+	defer index.Close()
+
+	f, err := index.CreateField("f", FieldOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := index.CreateField("v", FieldOptions{Type: FieldTypeInt, Min: 0, Max: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.SetBit(1, 3, nil); err != nil {
+		t.Fatal(err)
+	} else if _, err := f.SetBit(2, ShardWidth+1, nil); err != nil {
+		t.Fatal(err)
+	} else if _, err := v.SetValue(5, 40); err != nil {
+		t.Fatal(err)
+	}
+
+	index.trackExistence = true
+	if err := index.saveMeta(); err != nil {
+		t.Fatal(err)
+	} else if err := index.reopen(); err != nil {
+		t.Fatal(err)
+	}
+
+	if row, err := index.existenceField().Row(0); err != nil {
+		t.Fatal(err)
+	} else if columns := row.Columns(); !reflect.DeepEqual(columns, []uint64{3, 5, ShardWidth + 1}) {
+		t.Fatalf("unexpected columns: %+v", columns)
+	}
+}
@@ -61,7 +61,8 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type IndexMeta struct {
-	Keys bool `protobuf:"varint,3,opt,name=Keys,proto3" json:"Keys,omitempty"`
+	Keys           bool `protobuf:"varint,3,opt,name=Keys,proto3" json:"Keys,omitempty"`
+	TrackExistence bool `protobuf:"varint,4,opt,name=TrackExistence,proto3" json:"TrackExistence,omitempty"`
 }
 
 func (m *IndexMeta) Reset()                    { *m = IndexMeta{} }
@@ -76,14 +77,26 @@ func (m *IndexMeta) GetKeys() bool {
 	return false
 }
 
+func (m *IndexMeta) GetTrackExistence() bool {
+	if m != nil {
+		return m.TrackExistence
+	}
+	return false
+}
+
 type FieldOptions struct {
-	Type        string `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
-	CacheType   string `protobuf:"bytes,3,opt,name=CacheType,proto3" json:"CacheType,omitempty"`
-	CacheSize   uint32 `protobuf:"varint,4,opt,name=CacheSize,proto3" json:"CacheSize,omitempty"`
-	Min         int64  `protobuf:"varint,9,opt,name=Min,proto3" json:"Min,omitempty"`
-	Max         int64  `protobuf:"varint,10,opt,name=Max,proto3" json:"Max,omitempty"`
-	TimeQuantum string `protobuf:"bytes,5,opt,name=TimeQuantum,proto3" json:"TimeQuantum,omitempty"`
-	Keys        bool   `protobuf:"varint,11,opt,name=Keys,proto3" json:"Keys,omitempty"`
+	Type           string `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
+	CacheType      string `protobuf:"bytes,3,opt,name=CacheType,proto3" json:"CacheType,omitempty"`
+	CacheSize      uint32 `protobuf:"varint,4,opt,name=CacheSize,proto3" json:"CacheSize,omitempty"`
+	Min            int64  `protobuf:"varint,9,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max            int64  `protobuf:"varint,10,opt,name=Max,proto3" json:"Max,omitempty"`
+	TimeQuantum    string `protobuf:"bytes,5,opt,name=TimeQuantum,proto3" json:"TimeQuantum,omitempty"`
+	Keys           bool   `protobuf:"varint,11,opt,name=Keys,proto3" json:"Keys,omitempty"`
+	Scale          int64  `protobuf:"varint,12,opt,name=Scale,proto3" json:"Scale,omitempty"`
+	TimeZone       string `protobuf:"bytes,13,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	NoStandardView bool   `protobuf:"varint,14,opt,name=NoStandardView,proto3" json:"NoStandardView,omitempty"`
+	TimeRetention  string `protobuf:"bytes,15,opt,name=TimeRetention,proto3" json:"TimeRetention,omitempty"`
+	TimeRollup     string `protobuf:"bytes,16,opt,name=TimeRollup,proto3" json:"TimeRollup,omitempty"`
 }
 
 func (m *FieldOptions) Reset()                    { *m = FieldOptions{} }
@@ -140,6 +153,41 @@ func (m *FieldOptions) GetKeys() bool {
 	return false
 }
 
+func (m *FieldOptions) GetScale() int64 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *FieldOptions) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *FieldOptions) GetNoStandardView() bool {
+	if m != nil {
+		return m.NoStandardView
+	}
+	return false
+}
+
+func (m *FieldOptions) GetTimeRetention() string {
+	if m != nil {
+		return m.TimeRetention
+	}
+	return ""
+}
+
+func (m *FieldOptions) GetTimeRollup() string {
+	if m != nil {
+		return m.TimeRollup
+	}
+	return ""
+}
+
 type ImportResponse struct {
 	Err string `protobuf:"bytes,1,opt,name=Err,proto3" json:"Err,omitempty"`
 }
@@ -429,8 +477,9 @@ func (m *Schema) GetIndexes() []*Index {
 }
 
 type Index struct {
-	Name   string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Fields []*Field `protobuf:"bytes,4,rep,name=Fields" json:"Fields,omitempty"`
+	Name   string     `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Meta   *IndexMeta `protobuf:"bytes,2,opt,name=Meta" json:"Meta,omitempty"`
+	Fields []*Field   `protobuf:"bytes,4,rep,name=Fields" json:"Fields,omitempty"`
 }
 
 func (m *Index) Reset()                    { *m = Index{} }
@@ -445,6 +494,13 @@ func (m *Index) GetName() string {
 	return ""
 }
 
+func (m *Index) GetMeta() *IndexMeta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
 func (m *Index) GetFields() []*Field {
 	if m != nil {
 		return m.Fields
@@ -992,6 +1048,16 @@ func (m *IndexMeta) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.TrackExistence {
+		dAtA[i] = 0x20
+		i++
+		if m.TrackExistence {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1053,6 +1119,41 @@ func (m *FieldOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Scale != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPrivate(dAtA, i, uint64(m.Scale))
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPrivate(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if m.NoStandardView {
+		dAtA[i] = 0x70
+		i++
+		if m.NoStandardView {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.TimeRetention) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPrivate(dAtA, i, uint64(len(m.TimeRetention)))
+		i += copy(dAtA[i:], m.TimeRetention)
+	}
+	if len(m.TimeRollup) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPrivate(dAtA, i, uint64(len(m.TimeRollup)))
+		i += copy(dAtA[i:], m.TimeRollup)
+	}
 	return i, nil
 }
 
@@ -1504,6 +1605,16 @@ func (m *Index) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPrivate(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if m.Meta != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPrivate(dAtA, i, uint64(m.Meta.Size()))
+		n10, err := m.Meta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	if len(m.Fields) > 0 {
 		for _, msg := range m.Fields {
 			dAtA[i] = 0x22
@@ -1579,11 +1690,11 @@ func (m *Node) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.URI.Size()))
-		n10, err := m.URI.MarshalTo(dAtA[i:])
+		n11, err := m.URI.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.IsCoordinator {
 		dAtA[i] = 0x18
@@ -1652,11 +1763,11 @@ func (m *NodeEventMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Node.Size()))
-		n11, err := m.Node.MarshalTo(dAtA[i:])
+		n12, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	return i, nil
 }
@@ -1680,31 +1791,31 @@ func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Node.Size()))
-		n12, err := m.Node.MarshalTo(dAtA[i:])
+		n13, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.MaxShards != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.MaxShards.Size()))
-		n13, err := m.MaxShards.MarshalTo(dAtA[i:])
+		n14, err := m.MaxShards.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if m.Schema != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Schema.Size()))
-		n14, err := m.Schema.MarshalTo(dAtA[i:])
+		n15, err := m.Schema.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	return i, nil
 }
@@ -1887,21 +1998,21 @@ func (m *ResizeInstruction) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Node.Size()))
-		n15, err := m.Node.MarshalTo(dAtA[i:])
+		n16, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.Coordinator != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Coordinator.Size()))
-		n16, err := m.Coordinator.MarshalTo(dAtA[i:])
+		n17, err := m.Coordinator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	if len(m.Sources) > 0 {
 		for _, msg := range m.Sources {
@@ -1919,21 +2030,21 @@ func (m *ResizeInstruction) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Schema.Size()))
-		n17, err := m.Schema.MarshalTo(dAtA[i:])
+		n18, err := m.Schema.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if m.ClusterStatus != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.ClusterStatus.Size()))
-		n18, err := m.ClusterStatus.MarshalTo(dAtA[i:])
+		n19, err := m.ClusterStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	return i, nil
 }
@@ -1957,11 +2068,11 @@ func (m *ResizeSource) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Node.Size()))
-		n19, err := m.Node.MarshalTo(dAtA[i:])
+		n20, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if len(m.Index) > 0 {
 		dAtA[i] = 0x12
@@ -2013,11 +2124,11 @@ func (m *ResizeInstructionComplete) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.Node.Size()))
-		n20, err := m.Node.MarshalTo(dAtA[i:])
+		n21, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if len(m.Error) > 0 {
 		dAtA[i] = 0x1a
@@ -2047,11 +2158,11 @@ func (m *SetCoordinatorMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.New.Size()))
-		n21, err := m.New.MarshalTo(dAtA[i:])
+		n22, err := m.New.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	return i, nil
 }
@@ -2075,11 +2186,11 @@ func (m *UpdateCoordinatorMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPrivate(dAtA, i, uint64(m.New.Size()))
-		n22, err := m.New.MarshalTo(dAtA[i:])
+		n23, err := m.New.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	return i, nil
 }
@@ -2174,6 +2285,9 @@ func (m *IndexMeta) Size() (n int) {
 	if m.Keys {
 		n += 2
 	}
+	if m.TrackExistence {
+		n += 2
+	}
 	return n
 }
 
@@ -2204,6 +2318,24 @@ func (m *FieldOptions) Size() (n int) {
 	if m.Keys {
 		n += 2
 	}
+	if m.Scale != 0 {
+		n += 1 + sovPrivate(uint64(m.Scale))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovPrivate(uint64(l))
+	}
+	if m.NoStandardView {
+		n += 2
+	}
+	l = len(m.TimeRetention)
+	if l > 0 {
+		n += 1 + l + sovPrivate(uint64(l))
+	}
+	l = len(m.TimeRollup)
+	if l > 0 {
+		n += 2 + l + sovPrivate(uint64(l))
+	}
 	return n
 }
 
@@ -2396,6 +2528,10 @@ func (m *Index) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPrivate(uint64(l))
 	}
+	if m.Meta != nil {
+		l = m.Meta.Size()
+		n += 1 + l + sovPrivate(uint64(l))
+	}
 	if len(m.Fields) > 0 {
 		for _, e := range m.Fields {
 			l = e.Size()
@@ -2737,6 +2873,26 @@ func (m *IndexMeta) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Keys = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackExistence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackExistence = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrivate(dAtA[iNdEx:])
@@ -2951,6 +3107,132 @@ func (m *FieldOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Keys = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoStandardView", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoStandardView = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRetention", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeRetention = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRollup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeRollup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrivate(dAtA[iNdEx:])
@@ -4486,6 +4768,39 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = &IndexMeta{}
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
@@ -6699,70 +7014,74 @@ var (
 func init() { proto.RegisterFile("private.proto", fileDescriptorPrivate) }
 
 var fileDescriptorPrivate = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0x66, 0xbd, 0x6b, 0xc7, 0x7e, 0x53, 0x87, 0x64, 0x0a, 0x61, 0x8b, 0x50, 0x6a, 0x46, 0x95,
-	0x1a, 0x7a, 0x88, 0x4a, 0x7b, 0xe1, 0xab, 0x52, 0x14, 0x3b, 0xc0, 0x02, 0x09, 0x30, 0x9b, 0xf4,
-	0xd6, 0xc3, 0xd4, 0x1e, 0x35, 0xab, 0xac, 0x77, 0x96, 0xdd, 0xd9, 0x24, 0xee, 0x81, 0x2b, 0x5c,
-	0xb8, 0x23, 0x7e, 0x09, 0x3f, 0x81, 0x23, 0x3f, 0x01, 0x85, 0x3f, 0x82, 0xe6, 0x9d, 0xd9, 0x8f,
-	0xc4, 0x4e, 0x53, 0x85, 0xde, 0xe6, 0xfd, 0x7e, 0xe6, 0xfd, 0x9a, 0x81, 0x7e, 0x9a, 0x45, 0x27,
-	0x5c, 0x89, 0xad, 0x34, 0x93, 0x4a, 0x92, 0x6e, 0x94, 0x28, 0x91, 0x25, 0x3c, 0xa6, 0x77, 0xa1,
-	0x17, 0x24, 0x13, 0x71, 0xb6, 0x27, 0x14, 0x27, 0x04, 0xbc, 0x6f, 0xc5, 0x2c, 0xf7, 0xdd, 0x81,
-	0xb3, 0xd9, 0x65, 0x78, 0xa6, 0x7f, 0x3a, 0x70, 0xeb, 0xcb, 0x48, 0xc4, 0x93, 0xef, 0x53, 0x15,
-	0xc9, 0x24, 0x27, 0x1f, 0x40, 0x6f, 0xc8, 0xc7, 0x47, 0xe2, 0x60, 0x96, 0x0a, 0xd4, 0xec, 0xb1,
-	0x9a, 0x51, 0x49, 0xc3, 0xe8, 0xa5, 0xf0, 0xbd, 0x81, 0xb3, 0xd9, 0x67, 0x35, 0x83, 0x0c, 0x60,
-	0xf9, 0x20, 0x9a, 0x8a, 0x1f, 0x0b, 0x9e, 0xa8, 0x62, 0xea, 0xb7, 0xd1, 0xba, 0xc9, 0xd2, 0x10,
-	0xd0, 0x71, 0x17, 0x45, 0x78, 0x26, 0xab, 0xe0, 0xee, 0x45, 0x89, 0xdf, 0x1b, 0x38, 0x9b, 0x2e,
-	0xd3, 0x47, 0xe4, 0xf0, 0x33, 0x1f, 0x2c, 0x87, 0x9f, 0x55, 0xd0, 0x97, 0x1b, 0xd0, 0x29, 0xac,
-	0x04, 0xd3, 0x54, 0x66, 0x8a, 0x89, 0x3c, 0x95, 0x49, 0x8e, 0x9e, 0x76, 0xb3, 0xcc, 0x77, 0xd0,
-	0xb9, 0x3e, 0xd2, 0x9f, 0x61, 0x75, 0x27, 0x96, 0xe3, 0xe3, 0x11, 0x57, 0x9c, 0x89, 0x9f, 0x0a,
-	0x91, 0x2b, 0xf2, 0x0e, 0xb4, 0x31, 0x27, 0x56, 0xcf, 0x10, 0x9a, 0x8b, 0x79, 0xf0, 0x5b, 0x86,
-	0x8b, 0x84, 0xe6, 0xa2, 0x3d, 0x66, 0xc2, 0x63, 0x86, 0xd0, 0xdc, 0xf0, 0x88, 0x67, 0x13, 0xcc,
-	0x80, 0xc7, 0x0c, 0xa1, 0x31, 0x3e, 0x8d, 0xc4, 0xa9, 0xbd, 0x36, 0x9e, 0x69, 0x00, 0x6b, 0x8d,
-	0xf8, 0x16, 0xe6, 0x3a, 0x74, 0x98, 0x3c, 0x0d, 0x46, 0xb9, 0xef, 0x0c, 0xdc, 0x4d, 0x8f, 0x59,
-	0x0a, 0x93, 0x2b, 0xe3, 0x62, 0x9a, 0x68, 0x51, 0x0b, 0x45, 0x35, 0x83, 0xde, 0x81, 0x36, 0x66,
-	0x5a, 0xdf, 0xb2, 0xb6, 0xd5, 0x47, 0xfa, 0x8b, 0x03, 0xbd, 0x3d, 0x7e, 0x86, 0x30, 0x72, 0xf2,
-	0x04, 0xba, 0xa1, 0xe2, 0xc9, 0x44, 0x03, 0xd4, 0x4a, 0xcb, 0x8f, 0x3e, 0xdc, 0x2a, 0x1b, 0x62,
-	0xab, 0x52, 0xdb, 0x2a, 0x75, 0x76, 0x13, 0x95, 0xcd, 0x58, 0x65, 0xf2, 0xfe, 0xe7, 0xd0, 0xbf,
-	0x20, 0xd2, 0xf1, 0x8e, 0xc5, 0xac, 0xcc, 0xea, 0xb1, 0x98, 0xe9, 0xfb, 0x9f, 0xf0, 0xb8, 0x10,
-	0x98, 0x2b, 0x8f, 0x19, 0xe2, 0xb3, 0xd6, 0x27, 0x0e, 0xdd, 0x06, 0x32, 0xcc, 0x04, 0x57, 0x02,
-	0x83, 0xec, 0x89, 0x3c, 0xe7, 0x2f, 0xc4, 0xd5, 0x19, 0x37, 0x59, 0x6c, 0x35, 0xb2, 0x48, 0x1f,
-	0x00, 0x19, 0x89, 0x58, 0x28, 0x61, 0xfb, 0xf6, 0x15, 0x1e, 0x68, 0x58, 0x46, 0xbb, 0x5e, 0x97,
-	0xdc, 0x07, 0x4f, 0x0f, 0x01, 0x06, 0x5b, 0x7e, 0x74, 0xbb, 0xce, 0x48, 0x35, 0x1f, 0x0c, 0x15,
-	0x68, 0x5c, 0x3a, 0xc5, 0x0e, 0xb8, 0xf6, 0x0a, 0x0b, 0x9a, 0xe6, 0x81, 0x0d, 0xe5, 0x62, 0xa8,
-	0xf5, 0x3a, 0x54, 0x73, 0xd0, 0x6c, 0xb4, 0xed, 0xf2, 0xba, 0x37, 0x8d, 0x46, 0x9f, 0x59, 0xae,
-	0xee, 0xbf, 0x7d, 0x3e, 0x15, 0xd6, 0x06, 0xcf, 0x15, 0x94, 0xd6, 0xf5, 0x50, 0xb4, 0x7b, 0xdd,
-	0xb3, 0x7a, 0x3f, 0xb8, 0xda, 0x3d, 0x12, 0xf4, 0x31, 0x74, 0xc2, 0xf1, 0x91, 0x98, 0x72, 0xf2,
-	0x11, 0x2c, 0x21, 0x0e, 0x91, 0xdb, 0xb6, 0x7a, 0xfb, 0x52, 0x12, 0x59, 0x29, 0xa7, 0x23, 0x8b,
-	0x7f, 0x21, 0xa6, 0xfb, 0xd0, 0xc1, 0xe8, 0xb9, 0xef, 0x5d, 0x76, 0x83, 0x7c, 0x66, 0xc5, 0x74,
-	0x17, 0xdc, 0x43, 0x16, 0xe8, 0x71, 0x41, 0x04, 0xa5, 0x17, 0x4b, 0x69, 0xdf, 0x5f, 0xcb, 0x5c,
-	0xd9, 0x6c, 0xe0, 0x59, 0xf3, 0x7e, 0x90, 0x99, 0xc2, 0xd4, 0xf7, 0x19, 0x9e, 0xe9, 0x33, 0xf0,
-	0xf6, 0xe5, 0x44, 0x90, 0x15, 0x68, 0x05, 0x23, 0xeb, 0xa3, 0x15, 0x8c, 0xc8, 0x5d, 0x74, 0x6f,
-	0x53, 0xd3, 0xaf, 0x41, 0x1c, 0xb2, 0x80, 0x61, 0xe0, 0x7b, 0xd0, 0x0f, 0xf2, 0xa1, 0x94, 0xd9,
-	0x24, 0x4a, 0xb8, 0x92, 0x99, 0x5d, 0x9c, 0x17, 0x99, 0x74, 0x1b, 0x56, 0xb5, 0xfb, 0x50, 0x71,
-	0x25, 0xca, 0xfa, 0xad, 0x43, 0x47, 0xf3, 0xaa, 0x70, 0x96, 0xc2, 0x96, 0xd7, 0x7a, 0x65, 0x05,
-	0x91, 0xa0, 0xdf, 0x19, 0x0f, 0xbb, 0x27, 0x22, 0x51, 0x8d, 0x0e, 0x40, 0x1a, 0x1d, 0xf4, 0x99,
-	0x21, 0x08, 0x35, 0x57, 0xb1, 0x98, 0x57, 0x6a, 0xcc, 0x9a, 0xcb, 0x50, 0x46, 0x7f, 0x73, 0x00,
-	0x4a, 0x40, 0x45, 0x5e, 0x99, 0x38, 0x57, 0x9b, 0x90, 0x8f, 0x1b, 0xeb, 0x63, 0x7e, 0x40, 0x2a,
-	0x11, 0x6b, 0x2c, 0x99, 0xcd, 0xb2, 0x2d, 0x6c, 0x97, 0xaf, 0xd6, 0xfa, 0x86, 0x6f, 0xcb, 0xc4,
-	0x69, 0x04, 0xfd, 0x61, 0x5c, 0xe4, 0x4a, 0x64, 0x16, 0x91, 0x5e, 0x73, 0x86, 0x51, 0xe5, 0xa7,
-	0x66, 0x2c, 0x4e, 0x11, 0xb9, 0x07, 0x6d, 0x8d, 0xd4, 0xf4, 0xe6, 0xfc, 0x35, 0x8c, 0x90, 0x3e,
-	0x85, 0xee, 0x4e, 0x18, 0x7c, 0x95, 0xc9, 0x22, 0x5d, 0xd8, 0x79, 0xe5, 0xeb, 0xd3, 0x9a, 0x7f,
-	0x7d, 0xdc, 0xb9, 0xd7, 0xc7, 0xab, 0x5e, 0x1f, 0x1a, 0xc2, 0x9a, 0x59, 0x09, 0x7a, 0x24, 0x6e,
-	0xb2, 0x11, 0xca, 0xa7, 0xc1, 0x6d, 0x3c, 0x0d, 0x21, 0xac, 0x99, 0xc9, 0x7f, 0x93, 0x4e, 0xff,
-	0x68, 0xc1, 0x1a, 0x13, 0x79, 0xf4, 0x52, 0x04, 0x49, 0xae, 0xb2, 0x62, 0xac, 0x07, 0x5c, 0xdb,
-	0x7f, 0x23, 0x9f, 0xdb, 0x6c, 0xbb, 0xcc, 0x10, 0xaf, 0xd3, 0x4c, 0xe4, 0x21, 0x2c, 0x5f, 0x1e,
-	0x80, 0x79, 0xd5, 0xa6, 0x0a, 0x79, 0x08, 0x4b, 0xa1, 0x2c, 0xb2, 0xb1, 0x28, 0xc7, 0xbb, 0xb1,
-	0x74, 0x0c, 0x32, 0x23, 0x66, 0xa5, 0x5a, 0xa3, 0x95, 0xda, 0xaf, 0x6e, 0x25, 0xf2, 0xe4, 0x52,
-	0x2b, 0xf9, 0x1d, 0x34, 0x78, 0xaf, 0x36, 0xb8, 0x20, 0x66, 0x17, 0xb5, 0xe9, 0xaf, 0x0e, 0xdc,
-	0x6a, 0x42, 0x78, 0xad, 0xd9, 0xa8, 0x2a, 0xd2, 0x5a, 0x58, 0x11, 0x77, 0x51, 0x45, 0xbc, 0xba,
-	0x22, 0xf5, 0x2b, 0xd7, 0x6e, 0xbe, 0x72, 0xc7, 0x70, 0x67, 0xae, 0x4c, 0x43, 0x39, 0x4d, 0x75,
-	0x3f, 0xfc, 0x8f, 0x72, 0xe9, 0xad, 0x91, 0x65, 0xb6, 0x50, 0x3d, 0x66, 0x08, 0xfa, 0x29, 0xbc,
-	0x1b, 0x0a, 0xd5, 0x28, 0x52, 0xd9, 0x6d, 0x03, 0x70, 0xf7, 0xc5, 0xe9, 0x15, 0xd7, 0xd7, 0x22,
-	0xfa, 0x05, 0xf8, 0x87, 0xe9, 0x84, 0x2b, 0x71, 0x23, 0xeb, 0x1d, 0xe8, 0x1e, 0xc8, 0x54, 0xc6,
-	0xf2, 0xc5, 0xec, 0x9a, 0xa9, 0xf7, 0x61, 0xc9, 0xac, 0x48, 0xf3, 0xf1, 0xe9, 0xb1, 0x92, 0xa4,
-	0xb7, 0x75, 0x43, 0x8f, 0x79, 0x3c, 0x2e, 0x62, 0x0d, 0x43, 0xff, 0x80, 0xf2, 0x9d, 0xd5, 0xbf,
-	0xce, 0x37, 0x9c, 0xbf, 0xcf, 0x37, 0x9c, 0x7f, 0xce, 0x37, 0x9c, 0xdf, 0xff, 0xdd, 0x78, 0xeb,
-	0x79, 0x07, 0x7f, 0xbe, 0x8f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x39, 0x2f, 0x93, 0x68, 0x0a,
-	0x0b, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x73, 0x1b, 0xc5,
+	0x13, 0x2f, 0x69, 0x57, 0x8a, 0xd4, 0x8e, 0x1c, 0x7b, 0x92, 0xbf, 0xff, 0x9b, 0x14, 0x05, 0x62,
+	0xca, 0x45, 0x4c, 0x0e, 0xae, 0x90, 0x5c, 0x78, 0xa5, 0xca, 0xe5, 0x07, 0x61, 0x01, 0x1b, 0x18,
+	0xd9, 0x39, 0x50, 0x95, 0xc3, 0x44, 0xea, 0x8a, 0xb7, 0xbc, 0xda, 0x59, 0x76, 0x67, 0x6d, 0x2b,
+	0x07, 0xae, 0x70, 0xe1, 0x0b, 0xf0, 0xc9, 0xf8, 0x38, 0xd4, 0xf4, 0xcc, 0x3e, 0x64, 0xcb, 0xb1,
+	0xcb, 0x70, 0x9b, 0xfe, 0x75, 0x4f, 0xf7, 0xaf, 0x1f, 0xf3, 0x80, 0x41, 0x9a, 0x45, 0xa7, 0x52,
+	0xe3, 0x66, 0x9a, 0x29, 0xad, 0x58, 0x2f, 0x4a, 0x34, 0x66, 0x89, 0x8c, 0xf9, 0x4b, 0xe8, 0x87,
+	0xc9, 0x04, 0xcf, 0xf7, 0x51, 0x4b, 0xc6, 0xc0, 0xff, 0x1e, 0x67, 0x79, 0xe0, 0x0d, 0x5b, 0x1b,
+	0x3d, 0x41, 0x6b, 0xf6, 0x09, 0x2c, 0x1f, 0x66, 0x72, 0x7c, 0xb2, 0x77, 0x1e, 0xe5, 0x1a, 0x93,
+	0x31, 0x06, 0x3e, 0x69, 0x2f, 0xa0, 0xfc, 0xef, 0x36, 0xdc, 0xfd, 0x26, 0xc2, 0x78, 0xf2, 0x63,
+	0xaa, 0x23, 0x95, 0xe4, 0xc6, 0xd9, 0xe1, 0x2c, 0xc5, 0xa0, 0x37, 0x6c, 0x6d, 0xf4, 0x05, 0xad,
+	0xd9, 0x07, 0xd0, 0xdf, 0x91, 0xe3, 0x63, 0x24, 0x85, 0x47, 0x8a, 0x1a, 0xa8, 0xb4, 0xa3, 0xe8,
+	0x9d, 0x8d, 0x32, 0x10, 0x35, 0xc0, 0x56, 0xc0, 0xdb, 0x8f, 0x92, 0xa0, 0x3f, 0x6c, 0x6d, 0x78,
+	0xc2, 0x2c, 0x09, 0x91, 0xe7, 0x01, 0x38, 0x44, 0x9e, 0xb3, 0x21, 0x2c, 0x1d, 0x46, 0x53, 0xfc,
+	0xb9, 0x90, 0x89, 0x2e, 0xa6, 0x41, 0x87, 0x22, 0x34, 0xa1, 0x2a, 0xc5, 0xa5, 0x46, 0x8a, 0x0f,
+	0xa0, 0x33, 0x1a, 0xcb, 0x18, 0x83, 0xbb, 0xe4, 0xc9, 0x0a, 0xec, 0x11, 0xf4, 0xcc, 0xc6, 0x5f,
+	0x54, 0x82, 0xc1, 0x80, 0x1c, 0x55, 0xb2, 0x29, 0xca, 0x81, 0x1a, 0x69, 0x99, 0x4c, 0x64, 0x36,
+	0x79, 0x15, 0xe1, 0x59, 0xb0, 0x6c, 0x8b, 0x32, 0x8f, 0xb2, 0x75, 0x18, 0x98, 0x3d, 0x02, 0x35,
+	0x26, 0xa6, 0x2a, 0xc1, 0x3d, 0x72, 0x34, 0x0f, 0xb2, 0x0f, 0x01, 0x08, 0x50, 0x71, 0x5c, 0xa4,
+	0xc1, 0x0a, 0x99, 0x34, 0x10, 0xce, 0x61, 0x39, 0x9c, 0xa6, 0x2a, 0xd3, 0x02, 0xf3, 0x54, 0x25,
+	0x39, 0xd5, 0x62, 0x2f, 0xcb, 0x82, 0x16, 0x99, 0x9a, 0x25, 0xff, 0x0d, 0x56, 0xb6, 0x63, 0x35,
+	0x3e, 0xd9, 0x95, 0x5a, 0x0a, 0xfc, 0xb5, 0xc0, 0x5c, 0x9b, 0xbc, 0xa8, 0xb7, 0xce, 0xce, 0x0a,
+	0x06, 0xa5, 0x3e, 0x05, 0x6d, 0x8b, 0x92, 0x60, 0xea, 0x42, 0x79, 0xd8, 0x92, 0xd1, 0x9a, 0xea,
+	0x72, 0x2c, 0xb3, 0x09, 0xf5, 0xc2, 0x17, 0x56, 0x30, 0x28, 0x45, 0xa2, 0xfe, 0xf9, 0xc2, 0x0a,
+	0x3c, 0x84, 0xd5, 0x46, 0x7c, 0x47, 0x73, 0x0d, 0xba, 0x42, 0x9d, 0x85, 0xbb, 0x79, 0xd0, 0x1a,
+	0x7a, 0x1b, 0xbe, 0x70, 0x12, 0x35, 0x5a, 0xc5, 0xc5, 0x34, 0x31, 0xaa, 0x36, 0xa9, 0x6a, 0x80,
+	0x3f, 0x84, 0x0e, 0x75, 0xdd, 0x64, 0x59, 0xef, 0x35, 0x4b, 0xfe, 0x7b, 0x0b, 0xfa, 0xfb, 0xf2,
+	0x9c, 0x88, 0xe4, 0xec, 0x05, 0xf4, 0xca, 0x6a, 0x93, 0xd1, 0xd2, 0xb3, 0x8f, 0x37, 0xcb, 0xc1,
+	0xde, 0xac, 0xcc, 0x36, 0x4b, 0x9b, 0xbd, 0x44, 0x67, 0x33, 0x51, 0x6d, 0x79, 0xf4, 0x15, 0x0c,
+	0xe6, 0x54, 0x26, 0xde, 0x09, 0xce, 0xca, 0xaa, 0x9e, 0xe0, 0xcc, 0xe4, 0x7a, 0x2a, 0xe3, 0x02,
+	0xa9, 0x56, 0xbe, 0xb0, 0xc2, 0x97, 0xed, 0xcf, 0x5b, 0x7c, 0x0b, 0xd8, 0x4e, 0x86, 0x52, 0x23,
+	0x05, 0xd9, 0xc7, 0x3c, 0x97, 0x6f, 0xf1, 0xea, 0x8a, 0xdb, 0x3a, 0xb6, 0x1b, 0x75, 0xe4, 0x4f,
+	0x80, 0xed, 0x62, 0x8c, 0x1a, 0xdd, 0xf9, 0x7b, 0x8f, 0x07, 0x3e, 0x2a, 0xa3, 0x5d, 0x6f, 0xcb,
+	0x1e, 0x83, 0x6f, 0x0e, 0x33, 0x05, 0x5b, 0x7a, 0x76, 0xbf, 0xae, 0x48, 0x75, 0xce, 0x05, 0x19,
+	0xf0, 0xb8, 0x74, 0x4a, 0x13, 0x70, 0x6d, 0x0a, 0x0b, 0x86, 0xe6, 0x89, 0x0b, 0xe5, 0x51, 0xa8,
+	0xb5, 0x3a, 0x54, 0xf3, 0x22, 0x70, 0xd1, 0xb6, 0xca, 0x74, 0x6f, 0x1b, 0x8d, 0xbf, 0x86, 0x7a,
+	0x56, 0x0f, 0xe4, 0x14, 0xdd, 0x1e, 0x5a, 0x57, 0x54, 0xda, 0xd7, 0x53, 0x31, 0xee, 0xcd, 0x7c,
+	0x9b, 0x7b, 0xce, 0x33, 0xee, 0x49, 0xe0, 0xcf, 0xa1, 0x3b, 0x1a, 0x1f, 0xe3, 0x54, 0xb2, 0x4f,
+	0xe1, 0x0e, 0xf1, 0xc0, 0xdc, 0x8d, 0xd5, 0xbd, 0x0b, 0x45, 0x14, 0xa5, 0x9e, 0x4f, 0x1d, 0xff,
+	0x85, 0x9c, 0x6e, 0xda, 0x09, 0xf6, 0x18, 0xba, 0x44, 0x33, 0x0f, 0xfc, 0x8b, 0xf1, 0x08, 0x17,
+	0x4e, 0xcd, 0xf7, 0xc0, 0x3b, 0x12, 0x21, 0x5b, 0x73, 0x54, 0xcb, 0x70, 0x4e, 0x32, 0x24, 0xbe,
+	0x55, 0xb9, 0x76, 0x65, 0xa3, 0xb5, 0xc1, 0x7e, 0x52, 0x99, 0xa6, 0x1e, 0x0d, 0x04, 0xad, 0xf9,
+	0x6b, 0xf0, 0x0f, 0xd4, 0x04, 0xd9, 0x32, 0xb4, 0xc3, 0x5d, 0xe7, 0xa3, 0x1d, 0xee, 0xb2, 0x8f,
+	0xc8, 0xbd, 0xe3, 0x3b, 0xa8, 0x49, 0x1c, 0x89, 0x50, 0x50, 0xe0, 0x75, 0x18, 0x84, 0xf9, 0x8e,
+	0x52, 0xd9, 0x24, 0x4a, 0xa4, 0x56, 0x99, 0x7b, 0x29, 0xe6, 0x41, 0xbe, 0x05, 0x2b, 0xc6, 0xfd,
+	0x48, 0x4b, 0x8d, 0x65, 0xa3, 0xd7, 0xa0, 0x6b, 0xb0, 0x2a, 0x9c, 0x93, 0xe8, 0x6c, 0x18, 0xbb,
+	0xb2, 0xd5, 0x24, 0xf0, 0x1f, 0xac, 0x87, 0xbd, 0x53, 0x4c, 0x74, 0x63, 0x54, 0x48, 0x26, 0x07,
+	0x03, 0x61, 0x05, 0xc6, 0x6d, 0x2a, 0x8e, 0xf3, 0x72, 0xcd, 0xd9, 0xa0, 0x82, 0x74, 0xfc, 0xcf,
+	0x16, 0x40, 0x49, 0xa8, 0xc8, 0xab, 0x2d, 0xad, 0xab, 0xb7, 0xb0, 0xcf, 0x1a, 0xf7, 0xcc, 0xe5,
+	0xfe, 0x55, 0x2a, 0x51, 0x5b, 0xb1, 0x8d, 0x72, 0x7e, 0xdc, 0x71, 0x58, 0xa9, 0xed, 0x2d, 0xee,
+	0xda, 0x24, 0x79, 0x04, 0x83, 0x9d, 0xb8, 0xc8, 0x35, 0x66, 0x8e, 0x91, 0xb9, 0x0f, 0x2d, 0x50,
+	0xd5, 0xa7, 0x06, 0x16, 0x97, 0x88, 0xad, 0x43, 0xc7, 0x30, 0xb5, 0x43, 0x7c, 0x39, 0x0d, 0xab,
+	0xe4, 0xaf, 0xa0, 0xb7, 0x3d, 0x0a, 0x5f, 0x66, 0xaa, 0x48, 0x17, 0x8e, 0x68, 0xf9, 0x48, 0xb7,
+	0x1b, 0x8f, 0xb4, 0x7b, 0x68, 0xbd, 0x4b, 0x0f, 0xad, 0x5f, 0x3d, 0xb4, 0x7c, 0x04, 0xab, 0xf6,
+	0xee, 0x30, 0x67, 0xe7, 0x36, 0x57, 0x47, 0xf9, 0xde, 0x78, 0xf5, 0x7b, 0x63, 0x9c, 0xda, 0x2b,
+	0xe2, 0xbf, 0x74, 0xfa, 0x57, 0x1b, 0x56, 0x05, 0xe6, 0xd1, 0x3b, 0x0c, 0x93, 0x5c, 0x67, 0xc5,
+	0x98, 0x9e, 0xdc, 0x07, 0xd0, 0xf9, 0x4e, 0xbd, 0x71, 0xd5, 0xf6, 0x84, 0x15, 0x6e, 0x32, 0x4c,
+	0xec, 0x29, 0x2c, 0x5d, 0x3c, 0x00, 0x97, 0x4d, 0x9b, 0x26, 0xec, 0x29, 0xdc, 0x19, 0xa9, 0x22,
+	0x1b, 0x63, 0x79, 0xbc, 0x1b, 0xb7, 0x93, 0x65, 0x66, 0xd5, 0xa2, 0x34, 0x6b, 0x8c, 0x52, 0xe7,
+	0xfd, 0xa3, 0xc4, 0x5e, 0x5c, 0x18, 0xa5, 0xa0, 0x4b, 0x1b, 0xfe, 0x5f, 0x6f, 0x98, 0x53, 0x8b,
+	0x79, 0x6b, 0xfe, 0x47, 0x0b, 0xee, 0x36, 0x29, 0xdc, 0xe8, 0x6c, 0x54, 0x1d, 0x69, 0x2f, 0xec,
+	0x88, 0xb7, 0xa8, 0x23, 0xfe, 0xa2, 0x6f, 0x45, 0xa7, 0xf9, 0x1c, 0x9e, 0xc0, 0xc3, 0x4b, 0x6d,
+	0xda, 0x51, 0xd3, 0xd4, 0xcc, 0xc3, 0xbf, 0x68, 0x97, 0xb9, 0x35, 0xb2, 0xcc, 0x35, 0xaa, 0x2f,
+	0xac, 0xc0, 0xbf, 0x80, 0xff, 0x8d, 0x50, 0x37, 0x9a, 0x54, 0x4e, 0xdb, 0x10, 0xbc, 0x03, 0x3c,
+	0xbb, 0x22, 0x7d, 0xa3, 0xe2, 0x5f, 0x43, 0x70, 0x94, 0x4e, 0xa4, 0xc6, 0x5b, 0xed, 0xde, 0x86,
+	0xde, 0xa1, 0x4a, 0x55, 0xac, 0xde, 0xce, 0xae, 0x39, 0xf5, 0x01, 0xdc, 0xb1, 0x57, 0xa4, 0xfd,
+	0x21, 0xf5, 0x45, 0x29, 0xf2, 0xfb, 0x66, 0xa0, 0xc7, 0x32, 0x1e, 0x17, 0xb1, 0xa1, 0x61, 0xbe,
+	0x4a, 0xf9, 0x9b, 0x2e, 0x7d, 0xec, 0x9f, 0xff, 0x33, 0x00, 0x87, 0xda, 0xd6, 0xcb, 0xe9, 0x0b,
+	0x00, 0x00,
 }
@@ -29,21 +29,35 @@ var (
 	ErrIndexExists   = errors.New("index already exists")
 	ErrIndexNotFound = errors.New("index not found")
 
+	// ErrIndexExistenceNotTracked is returned when a query requires column
+	// existence but the index was not created with existence tracking.
+	ErrIndexExistenceNotTracked = errors.New("index does not track existence")
+
 	// ErrFieldRequired is returned when no field is specified.
 	ErrFieldRequired = errors.New("field required")
 	ErrFieldExists   = errors.New("field already exists")
 	ErrFieldNotFound = errors.New("field not found")
 
-	ErrBSIGroupNotFound         = errors.New("bsigroup not found")
-	ErrBSIGroupExists           = errors.New("bsigroup already exists")
-	ErrBSIGroupNameRequired     = errors.New("bsigroup name required")
-	ErrInvalidBSIGroupType      = errors.New("invalid bsigroup type")
-	ErrInvalidBSIGroupRange     = errors.New("invalid bsigroup range")
-	ErrInvalidBSIGroupValueType = errors.New("invalid bsigroup value type")
-	ErrBSIGroupValueTooLow      = errors.New("bsigroup value too low")
-	ErrBSIGroupValueTooHigh     = errors.New("bsigroup value too high")
-	ErrInvalidRangeOperation    = errors.New("invalid range operation")
-	ErrInvalidBetweenValue      = errors.New("invalid value for between operation")
+	// ErrInvalidBoolValue is returned when a bool field is given a value
+	// other than true or false.
+	ErrInvalidBoolValue = errors.New("invalid bool value, must be true or false")
+
+	// ErrTimestampRequired is returned when a bit without a timestamp is
+	// written to a time field which has no standard view.
+	ErrTimestampRequired = errors.New("timestamp required, field has no standard view")
+
+	ErrBSIGroupNotFound          = errors.New("bsigroup not found")
+	ErrBSIGroupExists            = errors.New("bsigroup already exists")
+	ErrBSIGroupNameRequired      = errors.New("bsigroup name required")
+	ErrInvalidBSIGroupType       = errors.New("invalid bsigroup type")
+	ErrInvalidBSIGroupRange      = errors.New("invalid bsigroup range")
+	ErrInvalidBSIGroupValueType  = errors.New("invalid bsigroup value type")
+	ErrInvalidBSIGroupScale      = errors.New("invalid bsigroup scale")
+	ErrInvalidBSIGroupValueScale = errors.New("bsigroup value has more decimal places than the field scale")
+	ErrBSIGroupValueTooLow       = errors.New("bsigroup value too low")
+	ErrBSIGroupValueTooHigh      = errors.New("bsigroup value too high")
+	ErrInvalidRangeOperation     = errors.New("invalid range operation")
+	ErrInvalidBetweenValue       = errors.New("invalid value for between operation")
 
 	ErrInvalidView      = errors.New("invalid view")
 	ErrInvalidCacheType = errors.New("invalid cache type")
@@ -55,6 +69,9 @@ var (
 	ErrFragmentNotFound = errors.New("fragment not found")
 	ErrQueryRequired    = errors.New("query required")
 	ErrTooManyWrites    = errors.New("too many write commands")
+	ErrQueryTimeout     = errors.New("query timeout")
+	ErrQueryNotFound    = errors.New("query not found")
+	ErrQueryCancelled   = errors.New("query cancelled")
 
 	ErrClusterDoesNotOwnShard = errors.New("cluster does not own shard")
 
@@ -41,6 +41,10 @@ func (q *Query) startCall(name string) {
 
 	if len(q.callStack) == 1 {
 		q.Calls = append(q.Calls, newCall)
+	} else if q.lastField != "" {
+		// The call is the value of an argument, e.g. filter=Row(f=1).
+		q.callStack[len(q.callStack)-2].Args[q.lastField] = newCall
+		q.lastField = ""
 	} else {
 		calls := q.callStack[len(q.callStack)-2].Children
 		q.callStack[len(q.callStack)-2].Children = append(calls, newCall)
@@ -258,13 +262,23 @@ type Call struct {
 // Returns the field as a string if present, or an error if not.
 func (c *Call) FieldArg() (string, error) {
 	for arg := range c.Args {
-		if !strings.HasPrefix(arg, "_") {
+		if !strings.HasPrefix(arg, "_") && !IsConditionArg(arg) {
 			return arg, nil
 		}
 	}
 	return "", fmt.Errorf("No field argument specified")
 }
 
+// IsConditionArg returns true if arg is one of the arguments which guard a
+// write call with a precondition, such as Set(colID, field=rowID, ifNotSet="other:rowID").
+func IsConditionArg(arg string) bool {
+	switch arg {
+	case "ifSet", "ifNotSet", "ifValue":
+		return true
+	}
+	return false
+}
+
 // UintArg is for reading the value at key from call.Args as a uint64. If the
 // key is not in Call.Args, the value of the returned bool will be false, and
 // the error will be nil. The value is assumed to be a uint64 or an int64 and
@@ -345,6 +359,11 @@ func (c *Call) Clone() *Call {
 		Name: c.Name,
 		Args: CopyArgs(c.Args),
 	}
+	for k, v := range other.Args {
+		if call, ok := v.(*Call); ok {
+			other.Args[k] = call.Clone()
+		}
+	}
 	if c.Children != nil {
 		other.Children = make([]*Call, len(c.Children))
 		for i := range c.Children {
@@ -451,12 +470,17 @@ func (cond *Condition) IntSliceValue() ([]int64, error) {
 
 func FormatValue(v interface{}) string {
 	switch v := v.(type) {
+	case nil:
+		return "null"
 	case string:
 		return fmt.Sprintf("%q", v)
 	case []interface{}:
 		return fmt.Sprintf("%s", joinInterfaceSlice(v))
 	case []uint64:
 		return fmt.Sprintf("%s", joinUint64Slice(v))
+	case float64:
+		// Avoid exponent notation, which the parser does not accept.
+		return strconv.FormatFloat(v, 'f', -1, 64)
 	case time.Time:
 		return fmt.Sprintf("\"%s\"", v.Format(TimeFormat))
 	case *Condition:
@@ -481,6 +505,8 @@ func joinInterfaceSlice(a []interface{}) string {
 		switch v := a[i].(type) {
 		case string:
 			other[i] = fmt.Sprintf("%q", v)
+		case float64:
+			other[i] = strconv.FormatFloat(v, 'f', -1, 64)
 		default:
 			other[i] = fmt.Sprintf("%v", v)
 		}
@@ -41,6 +41,47 @@ func TestCall_String(t *testing.T) {
 			t.Fatalf("unexpected string: %s", s)
 		}
 	})
+	t.Run("Null", func(t *testing.T) {
+		c := &pql.Call{
+			Name: "Range",
+			Args: map[string]interface{}{
+				"field0": &pql.Condition{Op: pql.NEQ, Value: nil},
+			},
+		}
+		if s := c.String(); s != `Range(field0 != null)` {
+			t.Fatalf("unexpected string: %s", s)
+		}
+	})
+	t.Run("Float", func(t *testing.T) {
+		c := &pql.Call{
+			Name: "Range",
+			Args: map[string]interface{}{
+				"field0": &pql.Condition{Op: pql.BETWEEN, Value: []interface{}{0.00001, 1.5}},
+				"field1": &pql.Condition{Op: pql.GT, Value: 0.00002},
+			},
+		}
+		if s := c.String(); s != `Range(field0 >< [0.00001,1.5], field1 > 0.00002)` {
+			t.Fatalf("unexpected string: %s", s)
+		}
+	})
+}
+
+// Ensure the field argument of a write call ignores preconditions.
+func TestCall_FieldArg(t *testing.T) {
+	c := &pql.Call{
+		Name: "Set",
+		Args: map[string]interface{}{
+			"_col":     uint64(1),
+			"ifNotSet": "g:2",
+			"ifSet":    "h:3",
+			"f":        int64(1),
+		},
+	}
+	if field, err := c.FieldArg(); err != nil {
+		t.Fatal(err)
+	} else if field != "f" {
+		t.Fatalf("unexpected field: %s", field)
+	}
 }
 
 // Ensure condition can handle values for BETWEEN operator.
@@ -95,6 +95,13 @@ const (
 	ruleAction41
 	ruleAction42
 	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruletimebound
+	ruletimeval
+	ruletimeexpr
+	ruleAction46
+	ruleAction47
 )
 
 var rul3s = [...]string{
@@ -178,6 +185,13 @@ var rul3s = [...]string{
 	"Action41",
 	"Action42",
 	"Action43",
+	"Action44",
+	"Action45",
+	"timebound",
+	"timeval",
+	"timeexpr",
+	"Action46",
+	"Action47",
 }
 
 type token32 struct {
@@ -294,7 +308,7 @@ type PQL struct {
 
 	Buffer string
 	buffer []rune
-	rules  [80]func() bool
+	rules  [87]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -474,6 +488,14 @@ func (p *PQL) Execute() {
 			p.addPosStr("_col", buffer[begin:end])
 		case ruleAction43:
 			p.addPosStr("_timestamp", buffer[begin:end])
+		case ruleAction44:
+			p.startCall("Rows")
+		case ruleAction45:
+			p.endCall()
+		case ruleAction46:
+			p.addField(buffer[begin:end])
+		case ruleAction47:
+			p.addVal(buffer[begin:end])
 
 		}
 	}
@@ -585,7 +607,7 @@ func (p *PQL) Init() {
 			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
-		/* 1 Call <- <(('S' 'e' 't' Action0 open col comma args (comma timestamp)? close Action1) / ('S' 'e' 't' 'R' 'o' 'w' 'A' 't' 't' 'r' 's' Action2 open posfield comma uintrow comma args close Action3) / ('S' 'e' 't' 'C' 'o' 'l' 'u' 'm' 'n' 'A' 't' 't' 'r' 's' Action4 open col comma args close Action5) / ('C' 'l' 'e' 'a' 'r' Action6 open col comma args close Action7) / ('T' 'o' 'p' 'N' Action8 open posfield (comma allargs)? close Action9) / ('R' 'a' 'n' 'g' 'e' Action10 open (timerange / conditional / arg) close Action11) / (<IDENT> Action12 open allargs comma? close Action13))> */
+		/* 1 Call <- <(('S' 'e' 't' Action0 open col comma args (comma timestamp)? close Action1) / ('S' 'e' 't' 'R' 'o' 'w' 'A' 't' 't' 'r' 's' Action2 open posfield comma uintrow comma args close Action3) / ('S' 'e' 't' 'C' 'o' 'l' 'u' 'm' 'n' 'A' 't' 't' 'r' 's' Action4 open col comma args close Action5) / ('C' 'l' 'e' 'a' 'r' Action6 open col comma args close Action7) / ('T' 'o' 'p' 'N' Action8 open posfield (comma allargs)? close Action9) / ('R' 'a' 'n' 'g' 'e' Action10 open (timerange / conditional / arg) close Action11) / ('R' 'o' 'w' 's' Action44 open posfield (comma allargs)? close Action45) / (<IDENT> Action12 open allargs comma? close Action13))> */
 		func() bool {
 			position5, tokenIndex5 := position, tokenIndex
 			{
@@ -628,9 +650,31 @@ func (p *PQL) Init() {
 							position12 := position
 							{
 								position13 := position
-								if !_rules[ruletimestampfmt]() {
-									goto l10
+								{
+									position323, tokenIndex323 := position, tokenIndex
+									if !_rules[ruletimestampfmt]() {
+										goto l324
+									}
+									goto l323
+								l324:
+									position, tokenIndex = position323, tokenIndex323
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l10
+									}
+									position++
+								l325:
+									{
+										position326, tokenIndex326 := position, tokenIndex
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l326
+										}
+										position++
+										goto l325
+									l326:
+										position, tokenIndex = position326, tokenIndex326
+									}
 								}
+							l323:
 								add(rulePegText, position13)
 							}
 							{
@@ -958,28 +1002,50 @@ func (p *PQL) Init() {
 								goto l36
 							}
 							{
-								position38 := position
-								if !_rules[ruletimestampfmt]() {
-									goto l36
+								position292, tokenIndex292 := position, tokenIndex
+								{
+									position38 := position
+									if !_rules[ruletimestampfmt]() {
+										goto l293
+									}
+									add(rulePegText, position38)
 								}
-								add(rulePegText, position38)
-							}
-							{
-								add(ruleAction26, position)
-							}
-							if !_rules[rulecomma]() {
-								goto l36
-							}
-							{
-								position40 := position
-								if !_rules[ruletimestampfmt]() {
+								{
+									add(ruleAction26, position)
+								}
+								if !_rules[rulecomma]() {
+									goto l293
+								}
+								{
+									position40 := position
+									if !_rules[ruletimestampfmt]() {
+										goto l293
+									}
+									add(rulePegText, position40)
+								}
+								{
+									add(ruleAction27, position)
+								}
+								goto l292
+							l293:
+								position, tokenIndex = position292, tokenIndex292
+								if !_rules[ruletimebound]() {
 									goto l36
 								}
-								add(rulePegText, position40)
-							}
-							{
-								add(ruleAction27, position)
+								{
+									position294, tokenIndex294 := position, tokenIndex
+									if !_rules[rulecomma]() {
+										goto l294
+									}
+									if !_rules[ruletimebound]() {
+										goto l294
+									}
+									goto l292
+								l294:
+									position, tokenIndex = position294, tokenIndex294
+								}
 							}
+						l292:
 							add(ruletimerange, position37)
 						}
 						goto l35
@@ -1040,6 +1106,53 @@ func (p *PQL) Init() {
 					}
 					goto l7
 				l33:
+					position, tokenIndex = position7, tokenIndex7
+					if buffer[position] != rune('R') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l286
+					}
+					position++
+					{
+						add(ruleAction44, position)
+					}
+					if !_rules[ruleopen]() {
+						goto l286
+					}
+					if !_rules[ruleposfield]() {
+						goto l286
+					}
+					{
+						position288, tokenIndex288 := position, tokenIndex
+						if !_rules[rulecomma]() {
+							goto l288
+						}
+						if !_rules[ruleallargs]() {
+							goto l288
+						}
+						goto l289
+					l288:
+						position, tokenIndex = position288, tokenIndex288
+					}
+				l289:
+					if !_rules[ruleclose]() {
+						goto l286
+					}
+					{
+						add(ruleAction45, position)
+					}
+					goto l7
+				l286:
 					position, tokenIndex = position7, tokenIndex7
 					{
 						position50 := position
@@ -1215,13 +1328,38 @@ func (p *PQL) Init() {
 			position, tokenIndex = position72, tokenIndex72
 			return false
 		},
-		/* 4 arg <- <((field sp '=' sp value) / (field sp COND sp value))> */
+		/* 4 arg <- <(timebound / (field sp '=' sp Call) / (field sp '=' sp value) / (field sp COND sp value))> */
 		func() bool {
 			position76, tokenIndex76 := position, tokenIndex
 			{
 				position77 := position
 				{
 					position78, tokenIndex78 := position, tokenIndex
+					if !_rules[ruletimebound]() {
+						goto l331
+					}
+					goto l78
+				l331:
+					position, tokenIndex = position78, tokenIndex78
+					if !_rules[rulefield]() {
+						goto l291
+					}
+					if !_rules[rulesp]() {
+						goto l291
+					}
+					if buffer[position] != rune('=') {
+						goto l291
+					}
+					position++
+					if !_rules[rulesp]() {
+						goto l291
+					}
+					if !_rules[ruleCall]() {
+						goto l291
+					}
+					goto l78
+				l291:
+					position, tokenIndex = position78, tokenIndex78
 					if !_rules[rulefield]() {
 						goto l79
 					}
@@ -1463,7 +1601,7 @@ func (p *PQL) Init() {
 		},
 		/* 9 condfield <- <(<fieldExpr> sp Action25)> */
 		nil,
-		/* 10 timerange <- <(field sp '=' sp value comma <timestampfmt> Action26 comma <timestampfmt> Action27)> */
+		/* 10 timerange <- <(field sp '=' sp value comma ((<timestampfmt> Action26 comma <timestampfmt> Action27) / (timebound (comma timebound)?)))> */
 		nil,
 		/* 11 value <- <(item / (lbrack Action28 list rbrack Action29))> */
 		func() bool {
@@ -2754,7 +2892,7 @@ func (p *PQL) Init() {
 			position, tokenIndex = position281, tokenIndex281
 			return false
 		},
-		/* 33 timestamp <- <(<timestampfmt> Action43)> */
+		/* 33 timestamp <- <(<(timestampfmt / [0-9]+)> Action43)> */
 		nil,
 		/* 35 Action0 <- <{p.startCall("Set")}> */
 		nil,
@@ -2845,6 +2983,246 @@ func (p *PQL) Init() {
 		nil,
 		/* 79 Action43 <- <{p.addPosStr("_timestamp", buffer[begin:end])}> */
 		nil,
+		/* 80 Action44 <- <{p.startCall("Rows")}> */
+		nil,
+		/* 81 Action45 <- <{p.endCall()}> */
+		nil,
+		/* 82 timebound <- <(<(('f' 'r' 'o' 'm') / ('t' 'o'))> Action46 sp '=' sp timeval)> */
+		func() bool {
+			position296, tokenIndex296 := position, tokenIndex
+			{
+				position297 := position
+				{
+					position298 := position
+					{
+						position299, tokenIndex299 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l300
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l300
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l300
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l300
+						}
+						position++
+						goto l299
+					l300:
+						position, tokenIndex = position299, tokenIndex299
+						if buffer[position] != rune('t') {
+							goto l296
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l296
+						}
+						position++
+					}
+				l299:
+					add(rulePegText, position298)
+				}
+				{
+					add(ruleAction46, position)
+				}
+				if !_rules[rulesp]() {
+					goto l296
+				}
+				if buffer[position] != rune('=') {
+					goto l296
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l296
+				}
+				{
+					position302 := position
+					{
+						position303, tokenIndex303 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l304
+						}
+						position++
+						{
+							position305 := position
+							if !_rules[ruletimeexpr]() {
+								goto l304
+							}
+							add(rulePegText, position305)
+						}
+						if buffer[position] != rune('"') {
+							goto l304
+						}
+						position++
+						goto l303
+					l304:
+						position, tokenIndex = position303, tokenIndex303
+						if buffer[position] != rune('\'') {
+							goto l306
+						}
+						position++
+						{
+							position307 := position
+							if !_rules[ruletimeexpr]() {
+								goto l306
+							}
+							add(rulePegText, position307)
+						}
+						if buffer[position] != rune('\'') {
+							goto l306
+						}
+						position++
+						goto l303
+					l306:
+						position, tokenIndex = position303, tokenIndex303
+						{
+							position308 := position
+							if !_rules[ruletimeexpr]() {
+								goto l296
+							}
+							add(rulePegText, position308)
+						}
+					}
+				l303:
+					{
+						add(ruleAction47, position)
+					}
+					add(ruletimeval, position302)
+				}
+				add(ruletimebound, position297)
+			}
+			return true
+		l296:
+			position, tokenIndex = position296, tokenIndex296
+			return false
+		},
+		/* 83 timeval <- <((('"' <timeexpr> '"') / ('\'' <timeexpr> '\'') / <timeexpr>) Action47)> */
+		nil,
+		/* 84 timeexpr <- <(('n' 'o' 'w') / timestampbasicfmt / (('-' / '+')? [0-9]+ ('y' / 'M' / 'w' / 'd' / 'h' / 'm')))> */
+		func() bool {
+			position310, tokenIndex310 := position, tokenIndex
+			{
+				position311 := position
+				{
+					position312, tokenIndex312 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l313
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l313
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l313
+					}
+					position++
+					goto l312
+				l313:
+					position, tokenIndex = position312, tokenIndex312
+					if !_rules[ruletimestampbasicfmt]() {
+						goto l314
+					}
+					goto l312
+				l314:
+					position, tokenIndex = position312, tokenIndex312
+					{
+						position315, tokenIndex315 := position, tokenIndex
+						{
+							position317, tokenIndex317 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l318
+							}
+							position++
+							goto l317
+						l318:
+							position, tokenIndex = position317, tokenIndex317
+							if buffer[position] != rune('+') {
+								goto l315
+							}
+							position++
+						}
+					l317:
+						goto l316
+					l315:
+						position, tokenIndex = position315, tokenIndex315
+					}
+				l316:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l310
+					}
+					position++
+				l319:
+					{
+						position320, tokenIndex320 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l320
+						}
+						position++
+						goto l319
+					l320:
+						position, tokenIndex = position320, tokenIndex320
+					}
+					{
+						position321, tokenIndex321 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l322
+						}
+						position++
+						goto l312
+					l322:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('M') {
+							goto l327
+						}
+						position++
+						goto l312
+					l327:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('w') {
+							goto l328
+						}
+						position++
+						goto l312
+					l328:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('d') {
+							goto l329
+						}
+						position++
+						goto l312
+					l329:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('h') {
+							goto l330
+						}
+						position++
+						goto l312
+					l330:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('m') {
+							goto l310
+						}
+						position++
+					}
+				}
+			l312:
+				add(ruletimeexpr, position311)
+			}
+			return true
+		l310:
+			position, tokenIndex = position310, tokenIndex310
+			return false
+		},
+		/* 85 Action46 <- <{p.addField(buffer[begin:end])}> */
+		nil,
+		/* 86 Action47 <- <{p.addVal(buffer[begin:end])}> */
+		nil,
 	}
 	p.rules = _rules
 }
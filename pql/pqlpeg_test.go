@@ -72,6 +72,10 @@ func TestPEGWorking(t *testing.T) {
 			name:   "SetTime",
 			input:  "Set(2, f=1, 1999-12-31T00:00)",
 			ncalls: 1},
+		{
+			name:   "SetEpoch",
+			input:  "Set(2, f=1, 1539696000)",
+			ncalls: 1},
 		{
 			name:   "DoubleSet",
 			input:  "Set(1, a=4)Set(2, a=4)",
@@ -224,6 +228,22 @@ func TestPEGWorking(t *testing.T) {
 			name:   "RangeTimeQuotes",
 			input:  `Range(a=4, '2010-07-04T00:00', "2010-08-04T00:00")`,
 			ncalls: 1},
+		{
+			name:   "RangeTimeRelative",
+			input:  "Range(a=4, from=-7d, to=now)",
+			ncalls: 1},
+		{
+			name:   "RangeTimeFrom",
+			input:  "Range(a=4, from='2010-07-04T00:00')",
+			ncalls: 1},
+		{
+			name:   "RangeTimeTo",
+			input:  "Range(a=4, to=+12h)",
+			ncalls: 1},
+		{
+			name:   "CountByTimeRelative",
+			input:  "CountByTime(Row(a=4), from=-7d, to=now, unit=D)",
+			ncalls: 1},
 		{
 			name:   "Dashed Frame",
 			input:  "Set(1, my-frame=9)",
@@ -275,6 +295,15 @@ func TestPEGErrors(t *testing.T) {
 		{
 			name:  "RangeTimeOneStamp",
 			input: "Range(a=4, 2010-07-04T00:00)"},
+		{
+			name:  "RangeTimeBadOffset",
+			input: "Range(a=4, from=-7days)"},
+		{
+			name:  "RangeTimeUpperUnit",
+			input: "Range(a=4, from=-7D)"},
+		{
+			name:  "RangeTimeUnknownUnit",
+			input: "Range(a=4, from=-7x, to=now)"},
 	}
 
 	for i, test := range tests {
@@ -304,6 +333,17 @@ func TestPQLDeepEquality(t *testing.T) {
 					"_timestamp": "2010-07-08T14:44",
 				},
 			}},
+		{
+			name: "SetEpoch",
+			call: "Set(1, a=7, 1539696000)",
+			exp: &Call{
+				Name: "Set",
+				Args: map[string]interface{}{
+					"a":          int64(7),
+					"_col":       int64(1),
+					"_timestamp": "1539696000",
+				},
+			}},
 		{
 			name: "SetRowAttrs",
 			call: "SetRowAttrs(myfield, 9, z=4)",
@@ -348,6 +388,17 @@ func TestPQLDeepEquality(t *testing.T) {
 					{Name: "Row"},
 				},
 			}},
+		{
+			name: "RangeTimeRelative",
+			call: "Range(a=7, from=-7d, to=now)",
+			exp: &Call{
+				Name: "Range",
+				Args: map[string]interface{}{
+					"a":    int64(7),
+					"from": "-7d",
+					"to":   "now",
+				},
+			}},
 		{
 			name: "RangeEQ",
 			call: "Range(a==7)",
@@ -522,6 +573,45 @@ func TestPQLDeepEquality(t *testing.T) {
 					{Name: "Row"},
 				},
 			}},
+		{
+			name: "Rows",
+			call: "Rows(f, previous=4, limit=10)",
+			exp: &Call{
+				Name: "Rows",
+				Args: map[string]interface{}{
+					"_field":   "f",
+					"previous": int64(4),
+					"limit":    int64(10),
+				},
+			}},
+		{
+			name: "RowsField",
+			call: "Rows(field=f)",
+			exp: &Call{
+				Name: "Rows",
+				Args: map[string]interface{}{
+					"field": "f",
+				},
+			}},
+		{
+			name: "GroupByFilter",
+			call: "GroupBy(Rows(a), Rows(b), filter=Row(active=1), limit=2)",
+			exp: &Call{
+				Name: "GroupBy",
+				Args: map[string]interface{}{
+					"filter": &Call{
+						Name: "Row",
+						Args: map[string]interface{}{
+							"active": int64(1),
+						},
+					},
+					"limit": int64(2),
+				},
+				Children: []*Call{
+					{Name: "Rows", Args: map[string]interface{}{"_field": "a"}},
+					{Name: "Rows", Args: map[string]interface{}{"_field": "b"}},
+				},
+			}},
 	}
 
 	for i, test := range tests {
@@ -0,0 +1,395 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pilosa/pilosa/internal"
+)
+
+// CallProfile holds the timings of a call executed with profiling enabled,
+// broken down by the nodes and shards which executed it.
+type CallProfile struct {
+	Call     string         `json:"call"`
+	Duration time.Duration  `json:"duration"`
+	Nodes    []*NodeProfile `json:"nodes,omitempty"`
+
+	// Row calls nested in the call. As they are executed separately for
+	// each shard, their duration is the total time spent on them across
+	// shards. Their reads also count towards the calls they are nested in.
+	Children []*CallProfile `json:"children,omitempty"`
+}
+
+// NodeProfile holds the time a node spent on a call. For remote nodes this
+// is the latency of the request as seen by the coordinating node.
+type NodeProfile struct {
+	ID       string          `json:"id"`
+	Remote   bool            `json:"remote"`
+	Duration time.Duration   `json:"duration"`
+	Shards   []*ShardProfile `json:"shards,omitempty"`
+}
+
+// ShardProfile holds the time spent mapping a call over a shard and the
+// storage it read to do so.
+type ShardProfile struct {
+	Shard    uint64        `json:"shard"`
+	Duration time.Duration `json:"duration"`
+
+	// Fragments read, as field/view.
+	Fragments []string `json:"fragments,omitempty"`
+
+	// Containers read from fragment storage. Rows served by the row cache
+	// are not counted, except by reads of int fields, which count the
+	// containers of every bit row they cover.
+	Containers uint64 `json:"containers"`
+
+	// Rows served by a fragment's row cache and reads of top rows served by
+	// a fragment's rank cache.
+	RowCacheHits  uint64 `json:"rowCacheHits"`
+	RankCacheHits uint64 `json:"rankCacheHits"`
+}
+
+func (p *ShardProfile) addFragment(name string) {
+	for _, other := range p.Fragments {
+		if other == name {
+			return
+		}
+	}
+	p.Fragments = append(p.Fragments, name)
+}
+
+// merge adds the timings and reads of other to p.
+func (p *ShardProfile) merge(other *ShardProfile) {
+	p.Duration += other.Duration
+	for _, name := range other.Fragments {
+		p.addFragment(name)
+	}
+	p.Containers += other.Containers
+	p.RowCacheHits += other.RowCacheHits
+	p.RankCacheHits += other.RankCacheHits
+}
+
+// node returns the profile of a node, adding it if necessary.
+func (p *CallProfile) node(id string, remote bool) *NodeProfile {
+	for _, np := range p.Nodes {
+		if np.ID == id {
+			return np
+		}
+	}
+	np := &NodeProfile{ID: id, Remote: remote}
+	p.Nodes = append(p.Nodes, np)
+	return np
+}
+
+// child returns the profile of a nested call, adding it if necessary.
+func (p *CallProfile) child(call string) *CallProfile {
+	for _, cp := range p.Children {
+		if cp.Call == call {
+			return cp
+		}
+	}
+	cp := &CallProfile{Call: call}
+	p.Children = append(p.Children, cp)
+	return cp
+}
+
+// mergeRemote adds the shards and nested calls of other, as profiled by the
+// remote node id, to p.
+func (p *CallProfile) mergeRemote(id string, other *CallProfile) {
+	np := p.node(id, true)
+	for _, onp := range other.Nodes {
+		for _, sp := range onp.Shards {
+			np.shard(sp.Shard).merge(sp)
+		}
+	}
+	for _, cp := range other.Children {
+		child := p.child(cp.Call)
+		child.Duration += cp.Duration
+		child.mergeRemote(id, cp)
+	}
+}
+
+func (p *NodeProfile) shard(shard uint64) *ShardProfile {
+	for _, sp := range p.Shards {
+		if sp.Shard == shard {
+			return sp
+		}
+	}
+	sp := &ShardProfile{Shard: shard}
+	p.Shards = append(p.Shards, sp)
+	return sp
+}
+
+type profileContextKey int
+
+const (
+	queryProfileKey profileContextKey = iota
+	callProfilerKey
+)
+
+// queryProfile collects the profiles of the calls in a query.
+type queryProfile struct {
+	calls []*CallProfile
+}
+
+// withQueryProfile returns a context which profiles the calls of a query
+// executed with it into qp.
+func withQueryProfile(ctx context.Context, qp *queryProfile) context.Context {
+	return context.WithValue(ctx, queryProfileKey, qp)
+}
+
+func queryProfileFrom(ctx context.Context) *queryProfile {
+	qp, _ := ctx.Value(queryProfileKey).(*queryProfile)
+	return qp
+}
+
+// start begins profiling a call executed on the local node. Returns ctx and
+// a nil profiler if qp is nil.
+func (qp *queryProfile) start(ctx context.Context, c fmt.Stringer, nodeID string) (context.Context, *callProfiler) {
+	if qp == nil {
+		return ctx, nil
+	}
+	p := &callProfiler{
+		mu:      &sync.Mutex{},
+		profile: &CallProfile{Call: c.String()},
+		nodeID:  nodeID,
+		start:   time.Now(),
+	}
+	qp.calls = append(qp.calls, p.profile)
+	return context.WithValue(ctx, callProfilerKey, p), p
+}
+
+// callProfiler records the profile of a single call. All methods are safe to
+// call on a nil profiler, which records nothing.
+type callProfiler struct {
+	mu      *sync.Mutex // shared by the profilers of nested calls
+	profile *CallProfile
+	parent  *callProfiler
+	nodeID  string
+	start   time.Time
+}
+
+func callProfilerFrom(ctx context.Context) *callProfiler {
+	p, _ := ctx.Value(callProfilerKey).(*callProfiler)
+	return p
+}
+
+// nested returns a context and profiler for a call nested in the call of p.
+// Returns ctx and a nil profiler if p is nil or c is the call of p itself.
+func (p *callProfiler) nested(ctx context.Context, c fmt.Stringer) (context.Context, *callProfiler) {
+	if p == nil {
+		return ctx, nil
+	}
+	call := c.String()
+	if call == p.profile.Call {
+		return ctx, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	child := &callProfiler{
+		mu:      p.mu,
+		profile: p.profile.child(call),
+		parent:  p,
+		nodeID:  p.nodeID,
+	}
+	return context.WithValue(ctx, callProfilerKey, child), child
+}
+
+// stop records the total duration of the call.
+func (p *callProfiler) stop() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.profile.Duration = time.Since(p.start)
+}
+
+// node returns the profile of a node, adding it if necessary. The caller
+// must hold p.mu.
+func (p *callProfiler) node(id string) *NodeProfile {
+	return p.profile.node(id, id != p.nodeID)
+}
+
+// addNodeTime adds d to the time spent on the call by a node.
+func (p *callProfiler) addNodeTime(id string, d time.Duration) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.node(id).Duration += d
+}
+
+// addShardTime adds d to the time spent mapping the call over a local shard.
+// For nested calls it is also added to the duration of the call.
+func (p *callProfiler) addShardTime(shard uint64, d time.Duration) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.node(p.nodeID).shard(shard).Duration += d
+	if p.parent != nil {
+		p.profile.Duration += d
+	}
+}
+
+// mergeRemote adds the shards profiled by a remote node to its profile.
+func (p *callProfiler) mergeRemote(id string, profiles []*CallProfile) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, cp := range profiles {
+		p.profile.mergeRemote(id, cp)
+	}
+}
+
+// record applies fn to the profile of the local shard of frag, after adding
+// frag to it, for p and each call p is nested in.
+func (p *callProfiler) record(frag *fragment, fn func(sp *ShardProfile)) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for ; p != nil; p = p.parent {
+		sp := p.node(p.nodeID).shard(frag.shard)
+		sp.addFragment(frag.field + "/" + frag.view)
+		fn(sp)
+	}
+}
+
+// touchFragment records a read of frag.
+func (p *callProfiler) touchFragment(frag *fragment) {
+	p.record(frag, func(sp *ShardProfile) {})
+}
+
+// readRow records a read of a row from frag. Rows read from storage add
+// their containers, rows served by the row cache count as a hit.
+func (p *callProfiler) readRow(frag *fragment, row *Row, cached bool) {
+	var n uint64
+	for _, seg := range row.segments {
+		n += uint64(seg.data.Containers.Size())
+	}
+	p.record(frag, func(sp *ShardProfile) {
+		if cached {
+			sp.RowCacheHits++
+		} else {
+			sp.Containers += n
+		}
+	})
+}
+
+// readRows records a read of the rows from start up to end of frag, such as
+// the bit rows of an int field, adding the containers they hold.
+func (p *callProfiler) readRows(frag *fragment, start, end uint64) {
+	if p == nil {
+		return
+	}
+	n := frag.containerN(start, end)
+	p.record(frag, func(sp *ShardProfile) {
+		sp.Containers += n
+	})
+}
+
+// readRankCache records a read of the top rows of frag from its rank cache.
+func (p *callProfiler) readRankCache(frag *fragment) {
+	p.record(frag, func(sp *ShardProfile) {
+		sp.RankCacheHits++
+	})
+}
+
+// fragmentRow returns a row of frag, recording the read in the call profile
+// carried by ctx, if any.
+func fragmentRow(ctx context.Context, frag *fragment, rowID uint64) *Row {
+	p := callProfilerFrom(ctx)
+	if p == nil {
+		return frag.row(rowID)
+	}
+	row, cached := frag.cachedRow(rowID)
+	p.readRow(frag, row, cached)
+	return row
+}
+
+// EncodeCallProfiles converts a into its internal representation.
+func EncodeCallProfiles(a []*CallProfile) []*internal.CallProfile {
+	other := make([]*internal.CallProfile, len(a))
+	for i, cp := range a {
+		other[i] = &internal.CallProfile{
+			Call:     cp.Call,
+			Duration: int64(cp.Duration),
+			Nodes:    make([]*internal.NodeProfile, len(cp.Nodes)),
+			Children: EncodeCallProfiles(cp.Children),
+		}
+		for j, np := range cp.Nodes {
+			other[i].Nodes[j] = &internal.NodeProfile{
+				ID:       np.ID,
+				Remote:   np.Remote,
+				Duration: int64(np.Duration),
+				Shards:   make([]*internal.ShardProfile, len(np.Shards)),
+			}
+			for k, sp := range np.Shards {
+				other[i].Nodes[j].Shards[k] = &internal.ShardProfile{
+					Shard:         sp.Shard,
+					Duration:      int64(sp.Duration),
+					Fragments:     sp.Fragments,
+					Containers:    sp.Containers,
+					RowCacheHits:  sp.RowCacheHits,
+					RankCacheHits: sp.RankCacheHits,
+				}
+			}
+		}
+	}
+	return other
+}
+
+func decodeCallProfiles(a []*internal.CallProfile) []*CallProfile {
+	other := make([]*CallProfile, len(a))
+	for i, cp := range a {
+		other[i] = &CallProfile{
+			Call:     cp.Call,
+			Duration: time.Duration(cp.Duration),
+			Nodes:    make([]*NodeProfile, len(cp.Nodes)),
+			Children: decodeCallProfiles(cp.Children),
+		}
+		for j, np := range cp.Nodes {
+			other[i].Nodes[j] = &NodeProfile{
+				ID:       np.ID,
+				Remote:   np.Remote,
+				Duration: time.Duration(np.Duration),
+				Shards:   make([]*ShardProfile, len(np.Shards)),
+			}
+			for k, sp := range np.Shards {
+				other[i].Nodes[j].Shards[k] = &ShardProfile{
+					Shard:         sp.Shard,
+					Duration:      time.Duration(sp.Duration),
+					Fragments:     sp.Fragments,
+					Containers:    sp.Containers,
+					RowCacheHits:  sp.RowCacheHits,
+					RankCacheHits: sp.RankCacheHits,
+				}
+			}
+		}
+	}
+	return other
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ActiveQuery describes a query running on the node which received it.
+type ActiveQuery struct {
+	ID    string    `json:"id"`
+	Index string    `json:"index"`
+	Query string    `json:"query"`
+	Start time.Time `json:"start"`
+
+	// Node which received the query and coordinates its execution.
+	Node string `json:"node"`
+
+	// Shards left to map for the call currently executing.
+	ShardsRemaining int `json:"shardsRemaining"`
+}
+
+// activeQuery is a running query in a queryRegistry.
+type activeQuery struct {
+	info   ActiveQuery
+	cancel context.CancelFunc
+
+	shards int64 // shards of the call currently executing
+	done   int64 // shards of the call mapped so far
+}
+
+// startShards notes that a call began mapping over n shards. All methods are
+// safe to call on a nil query, which records nothing.
+func (q *activeQuery) startShards(n int) {
+	if q == nil {
+		return
+	}
+	atomic.StoreInt64(&q.done, 0)
+	atomic.StoreInt64(&q.shards, int64(n))
+}
+
+// doneShards notes that n shards of the current call have been mapped.
+func (q *activeQuery) doneShards(n int) {
+	if q == nil {
+		return
+	}
+	atomic.AddInt64(&q.done, int64(n))
+}
+
+type registryContextKey int
+
+const activeQueryKey registryContextKey = iota
+
+func activeQueryFrom(ctx context.Context) *activeQuery {
+	q, _ := ctx.Value(activeQueryKey).(*activeQuery)
+	return q
+}
+
+// queryRegistry tracks the queries running on a node so that they can be
+// listed and cancelled.
+type queryRegistry struct {
+	mu      sync.Mutex
+	nodeID  string
+	seq     uint64
+	queries map[string]*activeQuery
+}
+
+func newQueryRegistry(nodeID string) *queryRegistry {
+	return &queryRegistry{
+		nodeID:  nodeID,
+		queries: make(map[string]*activeQuery),
+	}
+}
+
+// register adds a query to the registry. Returns a context which is cancelled
+// if the query is cancelled, and a func to remove the query once it returns.
+func (r *queryRegistry) register(ctx context.Context, index, query string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	q := &activeQuery{
+		info: ActiveQuery{
+			ID:    fmt.Sprintf("%s-%d", r.nodeID, r.seq),
+			Index: index,
+			Query: query,
+			Start: time.Now().UTC(),
+			Node:  r.nodeID,
+		},
+		cancel: cancel,
+	}
+	r.queries[q.info.ID] = q
+
+	return context.WithValue(ctx, activeQueryKey, q), func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.queries, q.info.ID)
+		cancel()
+	}
+}
+
+// list returns the queries in the registry, oldest first.
+func (r *queryRegistry) list() []ActiveQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a := make([]ActiveQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		info := q.info
+		info.ShardsRemaining = int(atomic.LoadInt64(&q.shards) - atomic.LoadInt64(&q.done))
+		a = append(a, info)
+	}
+	sortActiveQueries(a)
+	return a
+}
+
+// cancel cancels a query in the registry. Returns false if there is no query
+// with the ID.
+func (r *queryRegistry) cancel(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queries[id]
+	if !ok {
+		return false
+	}
+	q.cancel()
+	return true
+}
+
+// sortActiveQueries sorts a by start time, oldest first.
+func sortActiveQueries(a []ActiveQuery) {
+	sort.Slice(a, func(i, j int) bool {
+		if !a[i].Start.Equal(a[j].Start) {
+			return a[i].Start.Before(a[j].Start)
+		}
+		return a[i].ID < a[j].ID
+	})
+}
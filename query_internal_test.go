@@ -0,0 +1,60 @@
+// Copyright 2017 Pilosa Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pilosa
+
+import (
+	"context"
+	"testing"
+)
+
+// Ensure the query registry lists, tracks and cancels running queries.
+func TestQueryRegistry(t *testing.T) {
+	r := newQueryRegistry("node0")
+
+	ctx0, done0 := r.register(context.Background(), "i", "Count(Row(f=1))")
+	ctx1, done1 := r.register(context.Background(), "j", "TopN(f)")
+	defer done1()
+
+	// Note progress of the first query.
+	q := activeQueryFrom(ctx0)
+	q.startShards(4)
+	q.doneShards(1)
+
+	queries := r.list()
+	if len(queries) != 2 {
+		t.Fatalf("unexpected queries: %+v", queries)
+	} else if q := queries[0]; q.ID != "node0-1" || q.Index != "i" || q.Query != "Count(Row(f=1))" || q.Node != "node0" || q.ShardsRemaining != 3 {
+		t.Fatalf("unexpected query(0): %+v", q)
+	} else if q := queries[1]; q.ID != "node0-2" || q.ShardsRemaining != 0 {
+		t.Fatalf("unexpected query(1): %+v", q)
+	}
+
+	// Cancelling a query cancels its context only.
+	if !r.cancel("node0-2") {
+		t.Fatal("expected query to be cancelled")
+	} else if ctx1.Err() != context.Canceled {
+		t.Fatalf("unexpected error: %v", ctx1.Err())
+	} else if ctx0.Err() != nil {
+		t.Fatalf("unexpected error: %v", ctx0.Err())
+	} else if r.cancel("node0-3") {
+		t.Fatal("expected unknown query not to be cancelled")
+	}
+
+	// Queries leave the registry once they return.
+	done0()
+	if queries := r.list(); len(queries) != 1 || queries[0].ID != "node0-2" {
+		t.Fatalf("unexpected queries: %+v", queries)
+	}
+}
@@ -155,6 +155,30 @@ func (r *Row) Difference(other *Row) *Row {
 	return &Row{segments: segments}
 }
 
+// Shift returns a new row with every column moved by n positions. Columns
+// moved below zero are dropped, and columns moved past the edge of a shard are
+// carried into the segment of the neighbouring shard.
+func (r *Row) Shift(n int64) *Row {
+	other := NewRow()
+	for i := range r.segments {
+		s := &r.segments[i]
+
+		// Whole containers can be moved without visiting each bit.
+		if n%(1<<16) == 0 {
+			s.shiftContainers(other, n)
+			continue
+		}
+
+		itr := s.data.Iterator()
+		for v, eof := itr.Next(); !eof; v, eof = itr.Next() {
+			if col := int64(v) + n; col >= 0 {
+				other.SetBit(uint64(col))
+			}
+		}
+	}
+	return other
+}
+
 // SetBit sets the i-th column of the row.
 func (r *Row) SetBit(i uint64) (changed bool) {
 	return r.createSegmentIfNotExists(i / ShardWidth).SetBit(i)
@@ -404,6 +428,37 @@ func (s *RowSegment) ClearBit(i uint64) (changed bool) {
 	return changed
 }
 
+// shiftContainers adds the columns of s moved by n positions to other. n must
+// be a multiple of the roaring container width.
+func (s *RowSegment) shiftContainers(other *Row, n int64) {
+	start := int64(s.shard * ShardWidth)
+	end := start + ShardWidth
+
+	// The shifted segment covers at most two shards.
+	shard := int64(0)
+	if start+n > 0 {
+		shard = (start + n) / ShardWidth
+	}
+	for ; shard*ShardWidth < end+n; shard++ {
+		srcStart, srcEnd := start, end
+		if v := shard*ShardWidth - n; v > srcStart {
+			srcStart = v
+		}
+		if v := (shard+1)*ShardWidth - n; v < srcEnd {
+			srcEnd = v
+		}
+		if srcStart >= srcEnd {
+			continue
+		}
+
+		// Clone the containers as the source may be mapped fragment storage.
+		data := s.data.OffsetRange(uint64(srcStart+n), uint64(srcStart), uint64(srcEnd)).Clone()
+		seg := other.createSegmentIfNotExists(uint64(shard))
+		seg.data = *seg.data.Union(data)
+		seg.InvalidateCount()
+	}
+}
+
 // InvalidateCount updates the cached count in the row.
 func (s *RowSegment) InvalidateCount() {
 	s.n = s.data.Count()
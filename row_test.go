@@ -111,3 +111,27 @@ func TestRow_Difference_Segment(t *testing.T) {
 		t.Fatalf("Test 2 Difference Results %v != expected %v\n", res.Columns(), exp)
 	}
 }
+
+// Ensure a row's columns can be shifted across segments.
+func TestRow_Shift(t *testing.T) {
+	for _, tt := range []struct {
+		n   int64
+		exp []uint64
+	}{
+		{n: 0, exp: []uint64{0, 65535, ShardWidth - 1, ShardWidth, 2*ShardWidth + 3}},
+		{n: 1, exp: []uint64{1, 65536, ShardWidth, ShardWidth + 1, 2*ShardWidth + 4}},
+		{n: -1, exp: []uint64{65534, ShardWidth - 2, ShardWidth - 1, 2*ShardWidth + 2}},
+		{n: ShardWidth, exp: []uint64{ShardWidth, ShardWidth + 65535, 2*ShardWidth - 1, 2 * ShardWidth, 3*ShardWidth + 3}},
+		{n: 1 << 16, exp: []uint64{65536, 131071, ShardWidth + 65535, ShardWidth + 65536, 2*ShardWidth + 65539}},
+		{n: -(1 << 16), exp: []uint64{ShardWidth - 65537, ShardWidth - 65536, 2*ShardWidth - 65533}},
+	} {
+		row := pilosa.NewRow(0, 65535, ShardWidth-1, ShardWidth, 2*ShardWidth+3)
+		if columns := row.Shift(tt.n).Columns(); !reflect.DeepEqual(columns, tt.exp) {
+			t.Fatalf("n=%d: unexpected columns: %v", tt.n, columns)
+		} else if count := row.Shift(tt.n).Count(); count != uint64(len(tt.exp)) {
+			t.Fatalf("n=%d: unexpected count: %d", tt.n, count)
+		} else if count := row.Count(); count != 5 {
+			t.Fatalf("n=%d: source row modified: %v", tt.n, row.Columns())
+		}
+	}
+}
@@ -63,14 +63,22 @@ type Server struct {
 	gcNotifier GCNotifier
 	logger     Logger
 
-	nodeID              string
-	URI                 URI
-	antiEntropyInterval time.Duration
-	metricInterval      time.Duration
-	diagnosticInterval  time.Duration
-	maxWritesPerRequest int
-	isCoordinator       bool
-	syncer              holderSyncer
+	nodeID                string
+	URI                   URI
+	antiEntropyInterval   time.Duration
+	viewRetentionInterval time.Duration
+	viewRollupInterval    time.Duration
+	metricInterval        time.Duration
+	diagnosticInterval    time.Duration
+	maxWritesPerRequest   int
+	maxTimeBuckets        int
+	defaultQueryTimeout   time.Duration
+	maxQueryTimeout       time.Duration
+	isCoordinator         bool
+	syncer                holderSyncer
+	retainer              holderRetainer
+	compactor             holderCompactor
+	queries               *queryRegistry
 
 	primaryTranslateStore TranslateStore
 
@@ -121,6 +129,24 @@ func OptServerAntiEntropyInterval(interval time.Duration) ServerOption {
 	}
 }
 
+// OptServerViewRetentionInterval sets how often time views are checked
+// against their field's retention. A zero interval disables the check.
+func OptServerViewRetentionInterval(interval time.Duration) ServerOption {
+	return func(s *Server) error {
+		s.viewRetentionInterval = interval
+		return nil
+	}
+}
+
+// OptServerViewRollupInterval sets how often time views are checked against
+// their field's rollup. A zero interval disables the check.
+func OptServerViewRollupInterval(interval time.Duration) ServerOption {
+	return func(s *Server) error {
+		s.viewRollupInterval = interval
+		return nil
+	}
+}
+
 func OptServerLongQueryTime(dur time.Duration) ServerOption {
 	return func(s *Server) error {
 		s.cluster.longQueryTime = dur
@@ -135,6 +161,33 @@ func OptServerMaxWritesPerRequest(n int) ServerOption {
 	}
 }
 
+// OptServerMaxTimeBuckets sets the maximum number of buckets a CountByTime()
+// call may count. Zero means no limit.
+func OptServerMaxTimeBuckets(n int) ServerOption {
+	return func(s *Server) error {
+		s.maxTimeBuckets = n
+		return nil
+	}
+}
+
+// OptServerDefaultQueryTimeout sets the timeout of queries which do not set
+// one. A zero timeout disables it.
+func OptServerDefaultQueryTimeout(timeout time.Duration) ServerOption {
+	return func(s *Server) error {
+		s.defaultQueryTimeout = timeout
+		return nil
+	}
+}
+
+// OptServerMaxQueryTimeout sets the longest timeout a query may set. A zero
+// timeout disables the limit.
+func OptServerMaxQueryTimeout(timeout time.Duration) ServerOption {
+	return func(s *Server) error {
+		s.maxQueryTimeout = timeout
+		return nil
+	}
+}
+
 func OptServerMetricInterval(dur time.Duration) ServerOption {
 	return func(s *Server) error {
 		s.metricInterval = dur
@@ -235,9 +288,11 @@ func NewServer(opts ...ServerOption) (*Server, error) {
 
 		gcNotifier: NopGCNotifier,
 
-		antiEntropyInterval: time.Minute * 10,
-		metricInterval:      0,
-		diagnosticInterval:  0,
+		antiEntropyInterval:   time.Minute * 10,
+		viewRetentionInterval: time.Hour,
+		viewRollupInterval:    time.Hour,
+		metricInterval:        0,
+		diagnosticInterval:    0,
 
 		logger: NopLogger,
 	}
@@ -291,11 +346,14 @@ func NewServer(opts ...ServerOption) (*Server, error) {
 	// Append the NodeID tag to stats.
 	s.holder.Stats = s.holder.Stats.WithTags(fmt.Sprintf("NodeID:%s", s.nodeID))
 
+	s.queries = newQueryRegistry(s.nodeID)
+
 	s.executor.Holder = s.holder
 	s.executor.Node = node
 	s.executor.Cluster = s.cluster
 	s.executor.TranslateStore = s.translateFile
 	s.executor.MaxWritesPerRequest = s.maxWritesPerRequest
+	s.executor.MaxTimeBuckets = s.maxTimeBuckets
 	s.cluster.broadcaster = s
 	s.cluster.maxWritesPerRequest = s.maxWritesPerRequest
 	s.holder.broadcaster = s
@@ -349,9 +407,19 @@ func (s *Server) Open() error {
 	s.syncer.Closing = s.closing
 	s.syncer.Stats = s.holder.Stats.WithTags("HolderSyncer")
 
+	s.retainer.Holder = s.holder
+	s.retainer.Broadcaster = s
+	s.retainer.Closing = s.closing
+
+	s.compactor.Holder = s.holder
+	s.compactor.Logger = s.logger
+	s.compactor.Closing = s.closing
+
 	// Start background monitoring.
-	s.wg.Add(3)
+	s.wg.Add(5)
 	go func() { defer s.wg.Done(); s.monitorAntiEntropy() }()
+	go func() { defer s.wg.Done(); s.monitorViewRetention() }()
+	go func() { defer s.wg.Done(); s.monitorViewRollup() }()
 	go func() { defer s.wg.Done(); s.monitorRuntime() }()
 	go func() { defer s.wg.Done(); s.monitorDiagnostics() }()
 
@@ -431,6 +499,59 @@ func (s *Server) monitorAntiEntropy() {
 	}
 }
 
+func (s *Server) monitorViewRetention() {
+	if s.viewRetentionInterval == 0 {
+		return // view retention disabled
+	}
+	ticker := time.NewTicker(s.viewRetentionInterval)
+	defer ticker.Stop()
+
+	s.logger.Printf("view retention monitor initializing (%s interval)", s.viewRetentionInterval)
+
+	for {
+		// Wait for tick or a close.
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+		}
+
+		// Views are created on every node, so the coordinator alone decides
+		// which have expired and broadcasts the deletions.
+		if !s.cluster.isCoordinator() {
+			continue
+		}
+
+		if err := s.retainer.ExpireViews(time.Now()); err != nil {
+			s.logger.Printf("view retention error: err=%s", err)
+		}
+	}
+}
+
+func (s *Server) monitorViewRollup() {
+	if s.viewRollupInterval == 0 {
+		return // view rollup disabled
+	}
+	ticker := time.NewTicker(s.viewRollupInterval)
+	defer ticker.Stop()
+
+	s.logger.Printf("view rollup monitor initializing (%s interval)", s.viewRollupInterval)
+
+	for {
+		// Wait for tick or a close.
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+		}
+
+		// Every node folds the fragments it holds.
+		if err := s.compactor.CompactViews(time.Now()); err != nil {
+			s.logger.Printf("view compaction error: err=%s", err)
+		}
+	}
+}
+
 // ReceiveMessage represents an implementation of BroadcastHandler.
 func (s *Server) ReceiveMessage(pb proto.Message) error {
 	switch obj := pb.(type) {
@@ -441,7 +562,9 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 		}
 		idx.SetRemoteMaxShard(obj.Shard)
 	case *internal.CreateIndexMessage:
-		opt := IndexOptions{}
+		opt := IndexOptions{
+			TrackExistence: obj.GetMeta().GetTrackExistence(),
+		}
 		_, err := s.holder.CreateIndex(obj.Index, opt)
 		if err != nil {
 			return err
@@ -466,7 +589,7 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 			return err
 		}
 	case *internal.CreateViewMessage:
-		f := s.holder.Field(obj.Index, obj.Field)
+		f := s.holder.field(obj.Index, obj.Field)
 		if f == nil {
 			return fmt.Errorf("Local Field not found: %s", obj.Field)
 		}
@@ -475,12 +598,14 @@ func (s *Server) ReceiveMessage(pb proto.Message) error {
 			return err
 		}
 	case *internal.DeleteViewMessage:
-		f := s.holder.Field(obj.Index, obj.Field)
+		f := s.holder.field(obj.Index, obj.Field)
 		if f == nil {
 			return fmt.Errorf("Local Field not found: %s", obj.Field)
 		}
+		// Ignore a missing view; the sender may have expired a view this node
+		// never had.
 		err := f.deleteView(obj.View)
-		if err != nil {
+		if err != nil && err != ErrInvalidView {
 			return err
 		}
 	case *internal.ClusterStatus:
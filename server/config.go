@@ -51,6 +51,10 @@ type Config struct {
 	// SetRowAttrs & SetColumnAttrs.
 	MaxWritesPerRequest int `toml:"max-writes-per-request"`
 
+	// MaxTimeBuckets limits the number of time buckets a single CountByTime
+	// call can count. Zero means no limit.
+	MaxTimeBuckets int `toml:"max-time-buckets"`
+
 	// LogPath configures where Pilosa will write logs.
 	LogPath string `toml:"log-path"`
 
@@ -87,6 +91,22 @@ type Config struct {
 		Interval toml.Duration `toml:"interval"`
 	} `toml:"anti-entropy"`
 
+	Retention struct {
+		Interval toml.Duration `toml:"interval"`
+	} `toml:"retention"`
+
+	Rollup struct {
+		Interval toml.Duration `toml:"interval"`
+	} `toml:"rollup"`
+
+	// QueryTimeout bounds how long a query may run. Queries which do not set
+	// a timeout use Default and none may run longer than Max. Zero means no
+	// limit.
+	QueryTimeout struct {
+		Default toml.Duration `toml:"default"`
+		Max     toml.Duration `toml:"max"`
+	} `toml:"query-timeout"`
+
 	Metric struct {
 		// Service can be statsd, expvar, or none.
 		Service string `toml:"service"`
@@ -105,6 +125,7 @@ func NewConfig() *Config {
 		DataDir:             "~/.pilosa",
 		Bind:                ":10101",
 		MaxWritesPerRequest: 5000,
+		MaxTimeBuckets:      10000,
 		// LogPath: "",
 		// Verbose: false,
 		TLS: TLSConfig{},
@@ -133,6 +154,12 @@ func NewConfig() *Config {
 	// AntiEntropy config.
 	c.AntiEntropy.Interval = toml.Duration(10 * time.Minute)
 
+	// Retention config.
+	c.Retention.Interval = toml.Duration(time.Hour)
+
+	// Rollup config.
+	c.Rollup.Interval = toml.Duration(time.Hour)
+
 	// Metric config.
 	c.Metric.Service = "none"
 	// c.Metric.Host = ""
@@ -177,6 +177,62 @@ func TestHandler_Endpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Query timeout error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i0/query?timeout=soon", strings.NewReader("Count(Row(f0=30))")))
+		if w.Code != gohttp.StatusBadRequest {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		} else if body := w.Body.String(); body != `{"error":"invalid timeout argument"}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+	})
+
+	t.Run("Query timeout", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i0/query?timeout=1ns", strings.NewReader("Count(Row(f0=30))")))
+		if w.Code != gohttp.StatusRequestTimeout {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		} else if body := w.Body.String(); body != `{"error":"executing: query timeout"}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+
+		// Remote requests carry the time left of the coordinator's timeout.
+		buf, err := proto.Marshal(&internal.QueryRequest{
+			Query:   "Count(Row(f0=30))",
+			Remote:  true,
+			Timeout: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		w = httptest.NewRecorder()
+		r := test.MustNewHTTPRequest("POST", "/index/i0/query", bytes.NewReader(buf))
+		r.Header.Set("Content-Type", "application/x-protobuf")
+		r.Header.Set("Accept", "application/x-protobuf")
+		h.ServeHTTP(w, r)
+		if w.Code != gohttp.StatusRequestTimeout {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		}
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("GET", "/queries", nil))
+		if w.Code != gohttp.StatusOK {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		} else if body := w.Body.String(); body != `{"queries":[]}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("DELETE", "/queries/unknown", nil))
+		if w.Code != gohttp.StatusNotFound {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		} else if body := w.Body.String(); body != `{"success":false,"error":{"message":"query not found"}}`+"\n" {
+			t.Fatalf("unexpected body: %q", body)
+		}
+	})
+
 	t.Run("Query params err", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i0/query?shards=0,1&db=sample", strings.NewReader("Count(Row(f0=30))")))
@@ -206,6 +262,58 @@ func TestHandler_Endpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Profile JSON", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i0/query?profile=true", strings.NewReader("Count(Row(f0=30))")))
+		if w.Code != gohttp.StatusOK {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		}
+
+		var resp struct {
+			Results []uint64              `json:"results"`
+			Profile []*pilosa.CallProfile `json:"profile"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(resp.Results, []uint64{3}) {
+			t.Fatalf("unexpected results: %v", resp.Results)
+		} else if len(resp.Profile) != 1 || resp.Profile[0].Call != "Count(Row(f0=30))" {
+			t.Fatalf("unexpected profile: %s", w.Body.String())
+		} else if nodes := resp.Profile[0].Nodes; len(nodes) != 1 || len(nodes[0].Shards) == 0 {
+			t.Fatalf("unexpected profile nodes: %s", w.Body.String())
+		}
+	})
+
+	t.Run("Profile protobuf", func(t *testing.T) {
+		buf, err := proto.Marshal(&internal.QueryRequest{
+			Query:   "Count(Row(f0=30))",
+			Profile: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w := httptest.NewRecorder()
+		r := test.MustNewHTTPRequest("POST", "/index/i0/query", bytes.NewReader(buf))
+		r.Header.Set("Content-Type", "application/x-protobuf")
+		r.Header.Set("Accept", "application/x-protobuf")
+		h.ServeHTTP(w, r)
+		if w.Code != gohttp.StatusOK {
+			t.Fatalf("unexpected status code: %d", w.Code)
+		}
+
+		var resp internal.QueryResponse
+		if err := proto.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		} else if len(resp.Profile) != 1 || resp.Profile[0].Call != "Count(Row(f0=30))" {
+			t.Fatalf("unexpected profile: %+v", resp.Profile)
+		} else if nodes := resp.Profile[0].Nodes; len(nodes) != 1 || len(nodes[0].Shards) == 0 {
+			t.Fatalf("unexpected profile nodes: %+v", nodes)
+		} else if frags := nodes[0].Shards[0].Fragments; !reflect.DeepEqual(frags, []string{"f0/standard"}) {
+			t.Fatalf("unexpected fragments: %v", frags)
+		}
+	})
+
 	t.Run("Row JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		h.ServeHTTP(w, test.MustNewHTTPRequest("POST", "/index/i0/query", strings.NewReader("Row(f0=30)")))
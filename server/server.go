@@ -255,10 +255,15 @@ func (m *Command) SetupServer() error {
 
 	serverOptions := []pilosa.ServerOption{
 		pilosa.OptServerAntiEntropyInterval(time.Duration(m.Config.AntiEntropy.Interval)),
+		pilosa.OptServerViewRetentionInterval(time.Duration(m.Config.Retention.Interval)),
+		pilosa.OptServerViewRollupInterval(time.Duration(m.Config.Rollup.Interval)),
 		pilosa.OptServerLongQueryTime(time.Duration(m.Config.Cluster.LongQueryTime)),
 		pilosa.OptServerDataDir(m.Config.DataDir),
 		pilosa.OptServerReplicaN(m.Config.Cluster.ReplicaN),
 		pilosa.OptServerMaxWritesPerRequest(m.Config.MaxWritesPerRequest),
+		pilosa.OptServerMaxTimeBuckets(m.Config.MaxTimeBuckets),
+		pilosa.OptServerDefaultQueryTimeout(time.Duration(m.Config.QueryTimeout.Default)),
+		pilosa.OptServerMaxQueryTimeout(time.Duration(m.Config.QueryTimeout.Max)),
 		pilosa.OptServerMetricInterval(time.Duration(m.Config.Metric.PollInterval)),
 		pilosa.OptServerDiagnosticsInterval(diagnosticsInterval),
 
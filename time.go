@@ -17,6 +17,7 @@ package pilosa
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -24,7 +25,18 @@ import (
 // ErrInvalidTimeQuantum is returned when parsing a time quantum.
 var ErrInvalidTimeQuantum = errors.New("invalid time quantum")
 
-// TimeQuantum represents a time granularity for time-based bitmaps.
+// ErrInvalidTimeZone is returned when a field's time zone cannot be loaded.
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+// ErrInvalidTimeRetention is returned when parsing a time retention.
+var ErrInvalidTimeRetention = errors.New("invalid time retention")
+
+// ErrInvalidTimeRollup is returned when parsing a time rollup.
+var ErrInvalidTimeRollup = errors.New("invalid time rollup")
+
+// TimeQuantum represents a time granularity for time-based bitmaps. Its units
+// are year (Y), month (M), day (D), hour (H) and minute (T). Relative times,
+// such as those of parseTimeBound, use "m" for minutes instead.
 type TimeQuantum string
 
 // HasYear returns true if the quantum contains a 'Y' unit.
@@ -39,13 +51,17 @@ func (q TimeQuantum) HasDay() bool { return strings.ContainsRune(string(q), 'D')
 // HasHour returns true if the quantum contains a 'H' unit.
 func (q TimeQuantum) HasHour() bool { return strings.ContainsRune(string(q), 'H') }
 
+// HasMinute returns true if the quantum contains a 'T' unit.
+func (q TimeQuantum) HasMinute() bool { return strings.ContainsRune(string(q), 'T') }
+
 // Valid returns true if q is a valid time quantum value.
 func (q TimeQuantum) Valid() bool {
 	switch q {
-	case "Y", "YM", "YMD", "YMDH",
-		"M", "MD", "MDH",
-		"D", "DH",
-		"H",
+	case "Y", "YM", "YMD", "YMDH", "YMDHT",
+		"M", "MD", "MDH", "MDHT",
+		"D", "DH", "DHT",
+		"H", "HT",
+		"T",
 		"":
 		return true
 	default:
@@ -90,11 +106,89 @@ func viewByTimeUnit(name string, t time.Time, unit rune) string {
 		return fmt.Sprintf("%s_%s", name, t.Format("20060102"))
 	case 'H':
 		return fmt.Sprintf("%s_%s", name, t.Format("2006010215"))
+	case 'T':
+		return fmt.Sprintf("%s_%s", name, t.Format("200601021504"))
 	default:
 		return ""
 	}
 }
 
+// timeOfView returns the times at which a view generated by viewByTimeUnit
+// begins and ends. Returns false if view is not a time view of name.
+func timeOfView(name, view string) (start, end time.Time, ok bool) {
+	if !strings.HasPrefix(view, name+"_") {
+		return start, end, false
+	}
+	s := view[len(name)+1:]
+
+	var err error
+	switch len(s) {
+	case 4:
+		start, err = time.Parse("2006", s)
+		end = start.AddDate(1, 0, 0)
+	case 6:
+		start, err = time.Parse("200601", s)
+		end = start.AddDate(0, 1, 0)
+	case 8:
+		start, err = time.Parse("20060102", s)
+		end = start.AddDate(0, 0, 1)
+	case 10:
+		start, err = time.Parse("2006010215", s)
+		end = start.Add(time.Hour)
+	case 12:
+		start, err = time.Parse("200601021504", s)
+		end = start.Add(time.Minute)
+	default:
+		return start, end, false
+	}
+	return start, end, err == nil
+}
+
+// loadTimeZone returns the location named by name. An empty name is UTC.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+// wallTime returns the wall clock time of t in loc as a UTC time. Views are
+// named from wall clock times so that the views of a field with a time zone
+// split at its local midnight rather than at UTC midnight.
+func wallTime(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallTime is the inverse of wallTime. It returns the time in loc which
+// has the wall clock time of t.
+func fromWallTime(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// timeBuckets returns the start of each unit sized bucket which overlaps the
+// range from start to end. The first bucket may begin before start. Returns
+// false if there are more than max buckets, unless max is zero.
+func timeBuckets(start, end time.Time, unit rune, max int) ([]time.Time, bool) {
+	var a []time.Time
+	for t := start; t.Before(end); {
+		if max > 0 && len(a) == max {
+			return nil, false
+		}
+		from, to, ok := timeOfView(ViewStandard, viewByTimeUnit(ViewStandard, t, unit))
+		if !ok {
+			return nil, true
+		}
+		a = append(a, from)
+		t = to
+	}
+	return a, true
+}
+
 // viewsByTime returns a list of views for a given timestamp.
 func viewsByTime(name string, t time.Time, q TimeQuantum) []string {
 	a := make([]string, 0, len(q))
@@ -109,6 +203,8 @@ func viewsByTime(name string, t time.Time, q TimeQuantum) []string {
 }
 
 // viewsByTimeRange returns a list of views to traverse to query a time range.
+// The largest units which fit within the range are used so that a long range
+// does not read every view of a small unit.
 func viewsByTimeRange(name string, start, end time.Time, q TimeQuantum) []string {
 	t := start
 
@@ -117,12 +213,23 @@ func viewsByTimeRange(name string, start, end time.Time, q TimeQuantum) []string
 	hasMonth := q.HasMonth()
 	hasDay := q.HasDay()
 	hasHour := q.HasHour()
+	hasMinute := q.HasMinute()
 
 	var results []string
 
 	// Walk up from smallest units to largest units.
-	if hasHour || hasDay || hasMonth {
+	if hasMinute || hasHour || hasDay || hasMonth {
 		for t.Before(end) {
+			if hasMinute {
+				if !nextHourGTE(t, end) {
+					break
+				} else if t.Minute() != 0 {
+					results = append(results, viewByTimeUnit(name, t, 'T'))
+					t = t.Add(time.Minute)
+					continue
+				}
+			}
+
 			if hasHour {
 				if !nextDayGTE(t, end) {
 					break
@@ -172,9 +279,12 @@ func viewsByTimeRange(name string, start, end time.Time, q TimeQuantum) []string
 		} else if hasDay && nextDayGTE(t, end) {
 			results = append(results, viewByTimeUnit(name, t, 'D'))
 			t = t.AddDate(0, 0, 1)
-		} else if hasHour {
+		} else if hasHour && (!hasMinute || nextHourGTE(t, end)) {
 			results = append(results, viewByTimeUnit(name, t, 'H'))
 			t = t.Add(time.Hour)
+		} else if hasMinute {
+			results = append(results, viewByTimeUnit(name, t, 'T'))
+			t = t.Add(time.Minute)
 		} else {
 			break
 		}
@@ -210,3 +320,277 @@ func nextDayGTE(t time.Time, end time.Time) bool {
 	}
 	return end.After(next)
 }
+
+func nextHourGTE(t time.Time, end time.Time) bool {
+	next := t.Add(time.Hour)
+	y1, m1, d1 := next.Date()
+	y2, m2, d2 := end.Date()
+	if (y1 == y2) && (m1 == m2) && (d1 == d2) && (next.Hour() == end.Hour()) {
+		return true
+	}
+	return end.After(next)
+}
+
+// Timestamp formats which ParseTimestamp accepts besides time layouts.
+const (
+	TimestampFormatRFC3339    = "rfc3339"
+	TimestampFormatEpoch      = "s"
+	TimestampFormatEpochMilli = "ms"
+	TimestampFormatEpochNano  = "ns"
+)
+
+// ParseTimestamp parses the timestamp of a bit, v, in format. The format is
+// RFC3339, seconds, milliseconds or nanoseconds since the Unix epoch, or
+// otherwise a time layout such as TimeFormat. Surrounding quotes are ignored.
+//
+// Timestamps outside of the years 0 to 9999 are rejected, since views can't
+// be named for them. This also catches epoch values given in the wrong unit.
+func ParseTimestamp(v, format string) (time.Time, error) {
+	t, err := parseTimestamp(strings.Trim(v, `"'`), format)
+	if err != nil {
+		return time.Time{}, err
+	} else if t.Year() < 0 || t.Year() > 9999 {
+		return time.Time{}, fmt.Errorf("timestamp out of range: %s", v)
+	}
+	return t, nil
+}
+
+func parseTimestamp(v, format string) (time.Time, error) {
+
+	// Units per second of each epoch format.
+	var perSecond int64
+	switch format {
+	case TimestampFormatRFC3339:
+		t, err := time.Parse(time.RFC3339, v)
+		return t.UTC(), err
+	case TimestampFormatEpoch:
+		perSecond = 1
+	case TimestampFormatEpochMilli:
+		perSecond = 1e3
+	case TimestampFormatEpochNano:
+		perSecond = 1e9
+	default:
+		return time.Parse(format, v)
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	sec, frac := n/perSecond, n%perSecond
+	return time.Unix(sec, frac*(1e9/perSecond)).UTC(), nil
+}
+
+// parseTimeBound parses the bound of a time range relative to now. The bound
+// may be "now", a timestamp in TimeFormat, or a signed offset from now in
+// years (y), months (M), weeks (w), days (d), hours (h) or minutes (m), such
+// as "-7d". Units are case-sensitive, and minutes are "m" here while time
+// quantums use "T".
+func parseTimeBound(s string, now time.Time) (time.Time, error) {
+	if s == "now" {
+		return now, nil
+	} else if t, err := time.Parse(TimeFormat, s); err == nil {
+		return t, nil
+	}
+	return addTimeOffset(now, s)
+}
+
+// addTimeOffset returns t moved by a signed offset such as "-7d". The units
+// are the same as for parseTimeBound.
+func addTimeOffset(t time.Time, s string) (time.Time, error) {
+	if len(s) < 2 {
+		return time.Time{}, fmt.Errorf("invalid time: %q", s)
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time: %q", s)
+	}
+	switch s[len(s)-1] {
+	case 'y':
+		return t.AddDate(n, 0, 0), nil
+	case 'M':
+		return t.AddDate(0, n, 0), nil
+	case 'w':
+		return t.AddDate(0, 0, 7*n), nil
+	case 'd':
+		return t.AddDate(0, 0, n), nil
+	case 'h':
+		return t.Add(time.Duration(n) * time.Hour), nil
+	case 'm':
+		return t.Add(time.Duration(n) * time.Minute), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit: %q", s)
+	}
+}
+
+// TimeRetention lists how long the time views of each quantum unit are kept,
+// such as "H=90d,D=2y" to keep 90 days of hourly views and 2 years of daily
+// views. Ages use the units of relative Range() times and views of units
+// which are not listed are kept forever.
+type TimeRetention string
+
+// ParseTimeRetention parses v into a time retention.
+func ParseTimeRetention(v string) (TimeRetention, error) {
+	r := TimeRetention(v)
+	if _, err := r.cutoffs(time.Time{}); err != nil {
+		return "", err
+	}
+	return r, nil
+}
+
+// cutoffs returns the time for each unit before which the views of that unit
+// have expired at now.
+func (r TimeRetention) cutoffs(now time.Time) (map[rune]time.Time, error) {
+	m, ok := parseTimeAges(string(r), now)
+	if !ok {
+		return nil, ErrInvalidTimeRetention
+	}
+	return m, nil
+}
+
+// TimeRollup lists the age after which the time views of each quantum unit
+// are folded into the views of the next larger unit, such as "H=30d,D=1y" to
+// fold hourly views into daily views after 30 days and daily views into
+// monthly views after a year. Both units must be in the field's quantum.
+type TimeRollup string
+
+// ParseTimeRollup parses v into a time rollup.
+func ParseTimeRollup(v string) (TimeRollup, error) {
+	r := TimeRollup(v)
+	if _, err := r.cutoffs(time.Time{}); err != nil {
+		return "", err
+	}
+	return r, nil
+}
+
+// cutoffs returns the time for each unit before which the views of that unit
+// are folded at now. A larger unit may not be folded sooner than the smaller
+// units folded into it.
+func (r TimeRollup) cutoffs(now time.Time) (map[rune]time.Time, error) {
+	m, ok := parseTimeAges(string(r), now)
+	if !ok {
+		return nil, ErrInvalidTimeRollup
+	}
+	for unit, cutoff := range m {
+		parent, ok := parentTimeUnit(unit)
+		if !ok {
+			return nil, ErrInvalidTimeRollup
+		} else if c, ok := m[parent]; ok && c.After(cutoff) {
+			return nil, ErrInvalidTimeRollup
+		}
+	}
+	return m, nil
+}
+
+// parseTimeAges parses a comma separated list of unit=age items, such as
+// "H=90d,D=2y", into the time for each unit that is age before now.
+func parseTimeAges(s string, now time.Time) (map[rune]time.Time, bool) {
+	m := make(map[rune]time.Time)
+	if s == "" {
+		return m, true
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		i := strings.IndexByte(item, '=')
+		if i != 1 || !strings.Contains("YMDHT", item[:1]) {
+			return nil, false
+		}
+		unit, age := rune(item[0]), item[2:]
+		if _, ok := m[unit]; ok || age == "" || age[0] < '0' || age[0] > '9' {
+			return nil, false
+		}
+
+		cutoff, err := addTimeOffset(now, "-"+age)
+		if err != nil {
+			return nil, false
+		}
+		m[unit] = cutoff
+	}
+	return m, true
+}
+
+// parentTimeUnit returns the next larger unit than unit. Returns false for
+// years, which have no larger unit.
+func parentTimeUnit(unit rune) (rune, bool) {
+	switch unit {
+	case 'M':
+		return 'Y', true
+	case 'D':
+		return 'M', true
+	case 'H':
+		return 'D', true
+	case 'T':
+		return 'H', true
+	default:
+		return 0, false
+	}
+}
+
+// rollupViews replaces each view in names which the cutoffs have folded into
+// a larger unit with the view of that unit, dropping duplicates.
+func rollupViews(name string, names []string, cutoffs map[rune]time.Time) []string {
+	if len(cutoffs) == 0 {
+		return names
+	}
+
+	var results []string
+	seen := make(map[string]struct{})
+	for _, view := range names {
+		for {
+			start, end, ok := timeOfView(name, view)
+			if !ok {
+				break
+			}
+			unit := timeUnitOfView(name, view)
+			parent, ok := parentTimeUnit(unit)
+			if cutoff, folded := cutoffs[unit]; !ok || !folded || end.After(cutoff) {
+				break
+			}
+			view = viewByTimeUnit(name, start, parent)
+		}
+
+		if _, ok := seen[view]; !ok {
+			seen[view] = struct{}{}
+			results = append(results, view)
+		}
+	}
+	return results
+}
+
+// timeSpanOfViews returns the earliest start and latest end of the time views
+// in names. Returns false if names holds no time views.
+func timeSpanOfViews(name string, names []string) (start, end time.Time, ok bool) {
+	for _, view := range names {
+		vstart, vend, isTime := timeOfView(name, view)
+		if !isTime {
+			continue
+		}
+		if !ok || vstart.Before(start) {
+			start = vstart
+		}
+		if !ok || vend.After(end) {
+			end = vend
+		}
+		ok = true
+	}
+	return start, end, ok
+}
+
+// timeUnitOfView returns the unit of a view which timeOfView accepts.
+func timeUnitOfView(name, view string) rune {
+	switch len(view) - len(name) - 1 {
+	case 4:
+		return 'Y'
+	case 6:
+		return 'M'
+	case 8:
+		return 'D'
+	case 10:
+		return 'H'
+	case 12:
+		return 'T'
+	default:
+		return 0
+	}
+}
@@ -61,6 +61,11 @@ func TestViewByTimeUnit(t *testing.T) {
 			t.Fatalf("unexpected name: %s", s)
 		}
 	})
+	t.Run("T", func(t *testing.T) {
+		if s := viewByTimeUnit("F", ts, 'T'); s != "F_200001020304" {
+			t.Fatalf("unexpected name: %s", s)
+		}
+	})
 }
 
 // Ensure all applicable field names can be generated when mutating a time bit.
@@ -80,6 +85,13 @@ func TestViewsByTime(t *testing.T) {
 			t.Fatalf("unexpected names: %+v", a)
 		}
 	})
+
+	t.Run("HT", func(t *testing.T) {
+		a := viewsByTime("F", ts, mustParseTimeQuantum("ht"))
+		if !reflect.DeepEqual(a, []string{"F_2000010203", "F_200001020304"}) {
+			t.Fatalf("unexpected names: %+v", a)
+		}
+	})
 }
 
 // Ensure sets of fields can be returned for a given time range.
@@ -144,6 +156,223 @@ func TestViewsByTimeRange(t *testing.T) {
 			t.Fatalf("unexpected fields: %#v", a)
 		}
 	})
+	t.Run("YMDHT", func(t *testing.T) {
+		a := viewsByTimeRange("F", mustParseTime("2000-11-30 22:58"), mustParseTime("2001-01-01 01:02"), mustParseTimeQuantum("YMDHT"))
+		if !reflect.DeepEqual(a, []string{"F_200011302258", "F_200011302259", "F_2000113023", "F_200012", "F_2001010100", "F_200101010100", "F_200101010101"}) {
+			t.Fatalf("unexpected fields: %#v", a)
+		}
+	})
+	t.Run("HT", func(t *testing.T) {
+		a := viewsByTimeRange("F", mustParseTime("2000-01-01 00:59"), mustParseTime("2000-01-01 03:00"), mustParseTimeQuantum("HT"))
+		if !reflect.DeepEqual(a, []string{"F_200001010059", "F_2000010101", "F_2000010102"}) {
+			t.Fatalf("unexpected fields: %#v", a)
+		}
+	})
+	t.Run("T", func(t *testing.T) {
+		a := viewsByTimeRange("F", mustParseTime("2000-01-01 00:58"), mustParseTime("2000-01-01 01:01"), mustParseTimeQuantum("T"))
+		if !reflect.DeepEqual(a, []string{"F_200001010058", "F_200001010059", "F_200001010100"}) {
+			t.Fatalf("unexpected fields: %#v", a)
+		}
+	})
+	t.Run("WithinHour", func(t *testing.T) {
+		a := viewsByTimeRange("F", mustParseTime("2000-01-01 00:10"), mustParseTime("2000-01-01 00:12"), mustParseTimeQuantum("YMDHT"))
+		if !reflect.DeepEqual(a, []string{"F_200001010010", "F_200001010011"}) {
+			t.Fatalf("unexpected fields: %#v", a)
+		}
+	})
+}
+
+// Ensure the time covered by a view can be read from its name.
+func TestTimeOfView(t *testing.T) {
+	for _, tt := range []struct {
+		view       string
+		start, end string
+	}{
+		{view: "F_2000", start: "2000-01-01 00:00", end: "2001-01-01 00:00"},
+		{view: "F_200012", start: "2000-12-01 00:00", end: "2001-01-01 00:00"},
+		{view: "F_20000131", start: "2000-01-31 00:00", end: "2000-02-01 00:00"},
+		{view: "F_2000013123", start: "2000-01-31 23:00", end: "2000-02-01 00:00"},
+		{view: "F_200001312359", start: "2000-01-31 23:59", end: "2000-02-01 00:00"},
+	} {
+		if start, end, ok := timeOfView("F", tt.view); !ok {
+			t.Fatalf("%s: expected time view", tt.view)
+		} else if !start.Equal(mustParseTime(tt.start)) || !end.Equal(mustParseTime(tt.end)) {
+			t.Fatalf("%s: unexpected range: %s - %s", tt.view, start, end)
+		}
+	}
+
+	for _, view := range []string{"F", "G_2000", "F_20", "F_2000x"} {
+		if _, _, ok := timeOfView("F", view); ok {
+			t.Fatalf("%s: expected non-time view", view)
+		}
+	}
+}
+
+func TestTimeBuckets(t *testing.T) {
+	for _, tt := range []struct {
+		start, end string
+		unit       rune
+		exp        []string
+	}{
+		{start: "2000-01-30 12:00", end: "2000-02-02 00:00", unit: 'D', exp: []string{"2000-01-30 00:00", "2000-01-31 00:00", "2000-02-01 00:00"}},
+		{start: "2000-12-01 00:00", end: "2001-02-01 00:00", unit: 'M', exp: []string{"2000-12-01 00:00", "2001-01-01 00:00"}},
+		{start: "2000-01-01 22:30", end: "2000-01-01 23:01", unit: 'H', exp: []string{"2000-01-01 22:00", "2000-01-01 23:00"}},
+		{start: "2000-01-01 00:00", end: "2000-01-01 00:00", unit: 'Y', exp: nil},
+	} {
+		var exp []time.Time
+		for _, s := range tt.exp {
+			exp = append(exp, mustParseTime(s))
+		}
+		if a, ok := timeBuckets(mustParseTime(tt.start), mustParseTime(tt.end), tt.unit, 0); !ok || !reflect.DeepEqual(a, exp) {
+			t.Fatalf("%s - %s (%c): unexpected buckets: %v", tt.start, tt.end, tt.unit, a)
+		}
+	}
+
+	// Ensure ranges with more buckets than the maximum are rejected.
+	if _, ok := timeBuckets(mustParseTime("2000-01-30 12:00"), mustParseTime("2000-02-02 00:00"), 'D', 3); !ok {
+		t.Fatal("expected buckets within the maximum")
+	} else if _, ok := timeBuckets(mustParseTime("2000-01-30 12:00"), mustParseTime("2000-02-02 00:00"), 'D', 2); ok {
+		t.Fatal("expected too many buckets")
+	}
+}
+
+// Ensure times can be converted to and from wall clock times in a location.
+func TestWallTime(t *testing.T) {
+	loc, err := loadTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := mustParseTime("2000-01-31 20:30")
+	if v := wallTime(ts, loc); !v.Equal(mustParseTime("2000-02-01 05:30")) {
+		t.Fatalf("unexpected wall time: %s", v)
+	} else if v := fromWallTime(v, loc); !v.Equal(ts) {
+		t.Fatalf("unexpected time: %s", v)
+	}
+
+	if loc, err := loadTimeZone(""); err != nil || loc != time.UTC {
+		t.Fatalf("unexpected default location: %s, %v", loc, err)
+	} else if _, err := loadTimeZone("Mars/Olympus_Mons"); err != ErrInvalidTimeZone {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure relative and absolute time range bounds can be parsed.
+func TestParseTimeBound(t *testing.T) {
+	now := mustParseTime("2000-03-31 12:30")
+	for _, tt := range []struct {
+		s   string
+		exp string
+	}{
+		{s: "now", exp: "2000-03-31 12:30"},
+		{s: "1999-12-31T23:59", exp: "1999-12-31 23:59"},
+		{s: "-1y", exp: "1999-03-31 12:30"},
+		{s: "-1M", exp: "2000-03-02 12:30"},
+		{s: "-2w", exp: "2000-03-17 12:30"},
+		{s: "-7d", exp: "2000-03-24 12:30"},
+		{s: "+12h", exp: "2000-04-01 00:30"},
+		{s: "-30m", exp: "2000-03-31 12:00"},
+	} {
+		if v, err := parseTimeBound(tt.s, now); err != nil {
+			t.Fatalf("%s: %s", tt.s, err)
+		} else if !v.Equal(mustParseTime(tt.exp)) {
+			t.Fatalf("%s: unexpected time: %s", tt.s, v)
+		}
+	}
+
+	for _, s := range []string{"", "d", "later", "-7x", "2000-01-01"} {
+		if _, err := parseTimeBound(s, now); err == nil {
+			t.Fatalf("%s: expected error", s)
+		}
+	}
+}
+
+// Ensure a time retention can be parsed into per-unit cutoffs.
+func TestTimeRetention(t *testing.T) {
+	now := mustParseTime("2000-03-31 12:30")
+	cutoffs, err := TimeRetention("H=90d,D=2y,T=30m").cutoffs(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := map[rune]time.Time{
+		'H': mustParseTime("2000-01-01 12:30"),
+		'D': mustParseTime("1998-03-31 12:30"),
+		'T': mustParseTime("2000-03-31 12:00"),
+	}; !reflect.DeepEqual(cutoffs, exp) {
+		t.Fatalf("unexpected cutoffs: %v", cutoffs)
+	}
+
+	if _, err := ParseTimeRetention(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{"H", "H=", "X=1d", "HD=1d", "H=1d,H=2d", "H=-1d", "H=1x", "H=90d,"} {
+		if _, err := ParseTimeRetention(s); err != ErrInvalidTimeRetention {
+			t.Fatalf("%s: unexpected error: %v", s, err)
+		}
+	}
+}
+
+// Ensure a time rollup can be parsed and replaces folded views.
+func TestTimeRollup(t *testing.T) {
+	now := mustParseTime("2000-03-31 12:30")
+	cutoffs, err := TimeRollup("H=2d,D=1M").cutoffs(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	views := rollupViews("F", []string{"F_1999", "F_200002", "F_2000032822", "F_2000032823", "F_20000330", "F_2000033111"}, cutoffs)
+	if exp := []string{"F_1999", "F_200002", "F_20000328", "F_20000330", "F_2000033111"}; !reflect.DeepEqual(views, exp) {
+		t.Fatalf("unexpected views: %v", views)
+	}
+
+	// Folded views cascade up through several units.
+	views = rollupViews("F", []string{"F_2000010105"}, cutoffs)
+	if exp := []string{"F_200001"}; !reflect.DeepEqual(views, exp) {
+		t.Fatalf("unexpected cascaded views: %v", views)
+	}
+
+	for _, s := range []string{"Y=1y", "H=1d,H=2d", "H=30d,D=1d", "H=1x"} {
+		if _, err := ParseTimeRollup(s); err != ErrInvalidTimeRollup {
+			t.Fatalf("%s: unexpected error: %v", s, err)
+		}
+	}
+}
+
+// Ensure bit timestamps can be parsed in each format.
+func TestParseTimestamp(t *testing.T) {
+	exp := time.Date(2018, 10, 16, 13, 20, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		v      string
+		format string
+	}{
+		{v: "2018-10-16T13:20", format: TimeFormat},
+		{v: `"2018-10-16T13:20"`, format: TimeFormat},
+		{v: "2018-10-16T15:20:00+02:00", format: TimestampFormatRFC3339},
+		{v: "1539696000", format: TimestampFormatEpoch},
+		{v: "1539696000000", format: TimestampFormatEpochMilli},
+		{v: "1539696000000000000", format: TimestampFormatEpochNano},
+	} {
+		if v, err := ParseTimestamp(tt.v, tt.format); err != nil {
+			t.Fatalf("%s: %s", tt.v, err)
+		} else if !v.Equal(exp) || v.Location() != time.UTC {
+			t.Fatalf("%s: unexpected time: %s", tt.v, v)
+		}
+	}
+
+	if v, err := ParseTimestamp("-1500", TimestampFormatEpochMilli); err != nil || !v.Equal(time.Unix(-2, 5e8)) {
+		t.Fatalf("unexpected negative time: %s, %v", v, err)
+	}
+	for _, format := range []string{TimeFormat, TimestampFormatRFC3339, TimestampFormatEpoch} {
+		if _, err := ParseTimestamp("tomorrow", format); err == nil {
+			t.Fatalf("%s: expected error", format)
+		}
+	}
+
+	// Milliseconds read as seconds fall far beyond the year 9999.
+	if _, err := ParseTimestamp("1539696000000", TimestampFormatEpoch); err == nil || err.Error() != "timestamp out of range: 1539696000000" {
+		t.Fatalf("unexpected error for out of range time: %v", err)
+	} else if _, err := ParseTimestamp("-62167219201", TimestampFormatEpoch); err == nil {
+		t.Fatal("expected error for time before year 0")
+	}
 }
 
 // defaultTimeLayout is the time layout used by the tests.
@@ -46,6 +46,7 @@ type View struct {
 
 	// Fragments by shard.
 	cacheType string // passed in by field
+	mutex     bool   // passed in by field
 	fragments map[uint64]*fragment
 
 	// maxShard maintains this view's max shard in order to
@@ -250,6 +251,7 @@ func (v *View) newFragment(path string, shard uint64) *fragment {
 	frag := newFragment(path, v.index, v.field, v.name, shard)
 	frag.CacheType = v.cacheType
 	frag.CacheSize = v.cacheSize
+	frag.mutex = v.mutex
 	frag.Logger = v.Logger
 	frag.stats = v.stats.WithTags(fmt.Sprintf("shard:%d", shard))
 	return frag
@@ -319,6 +321,29 @@ func (v *View) clearBit(rowID, columnID uint64) (changed bool, err error) {
 	return frag.clearBit(rowID, columnID)
 }
 
+// bit returns true if a bit is set within the view.
+func (v *View) bit(rowID, columnID uint64) (bool, error) {
+	frag := v.Fragment(columnID / ShardWidth)
+	if frag == nil {
+		return false, nil
+	}
+	return frag.contains(rowID, columnID)
+}
+
+// setRow replaces a row within a shard with the columns of row in that shard.
+func (v *View) setRow(shard, rowID uint64, row *Row) (changed bool, err error) {
+	frag := v.Fragment(shard)
+	if frag == nil {
+		if row.segment(shard) == nil {
+			return false, nil
+		}
+		if frag, err = v.CreateFragmentIfNotExists(shard); err != nil {
+			return false, err
+		}
+	}
+	return frag.setRow(rowID, row)
+}
+
 // value uses a column of bits to read a multi-bit value.
 func (v *View) value(columnID uint64, bitDepth uint) (value uint64, exists bool, err error) {
 	shard := columnID / ShardWidth
@@ -339,6 +364,33 @@ func (v *View) setValue(columnID uint64, bitDepth uint, value uint64) (changed b
 	return frag.setValue(columnID, bitDepth, value)
 }
 
+// setValueIf sets the multi-bit value of a column if it holds the expected value.
+func (v *View) setValueIf(columnID uint64, bitDepth uint, value uint64, expected *uint64) (applied bool, err error) {
+	frag, err := v.CreateFragmentIfNotExists(columnID / ShardWidth)
+	if err != nil {
+		return false, err
+	}
+	return frag.setValueIf(columnID, bitDepth, value, expected)
+}
+
+// incrementValue adds delta to the multi-bit value of a column.
+func (v *View) incrementValue(columnID uint64, bitDepth uint, delta, zero int64, max uint64) (value uint64, err error) {
+	frag, err := v.CreateFragmentIfNotExists(columnID / ShardWidth)
+	if err != nil {
+		return value, err
+	}
+	return frag.incrementValue(columnID, bitDepth, delta, zero, max)
+}
+
+// clearValue removes the multi-bit value of a column.
+func (v *View) clearValue(columnID uint64, bitDepth uint) (changed bool, err error) {
+	frag := v.Fragment(columnID / ShardWidth)
+	if frag == nil {
+		return false, nil
+	}
+	return frag.clearValue(columnID, bitDepth)
+}
+
 // sum returns the sum & count of a field.
 func (v *View) sum(filter *Row, bitDepth uint) (sum, count uint64, err error) {
 	for _, f := range v.allFragments() {